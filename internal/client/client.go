@@ -0,0 +1,244 @@
+// Package client is a small JSON client for the JumpServer REST API.
+//
+// Authentication is not handled here: the wrapped *http.Client is expected to
+// carry a transport that signs or authorizes each request.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Client sends JSON requests to a JumpServer instance.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// New returns a Client sending requests through httpClient to the JumpServer
+// instance at baseURL, e.g. https://jumpserver.example.com.
+func New(httpClient *http.Client, baseURL string) *Client {
+	return &Client{
+		httpClient: httpClient,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// Error is returned for responses with a non-2xx status. The response body
+// has already been read into Body.
+type Error struct {
+	Response *http.Response
+	Body     []byte
+}
+
+func (e *Error) Error() string {
+	// A throttled request names how long to wait, so the message is useful
+	// even where the caller does not add a rate limit diagnostic.
+	if e.Response.StatusCode == http.StatusTooManyRequests {
+		if retryAfter := e.Response.Header.Get("Retry-After"); retryAfter != "" {
+			return fmt.Sprintf("unexpected status code: %s (retry after %s), Response: %s", e.Response.Status, retryAfter, string(e.Body))
+		}
+	}
+	return fmt.Sprintf("unexpected status code: %s, Response: %s", e.Response.Status, string(e.Body))
+}
+
+// StatusCode returns the HTTP status code of the response.
+func (e *Error) StatusCode() int {
+	return e.Response.StatusCode
+}
+
+// DecodeError is returned when a successful response body cannot be decoded
+// into the requested value.
+type DecodeError struct {
+	Body []byte
+	Err  error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("unable to decode response: %s", e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// OrgHeader is the header JumpServer reads the organization of a request from.
+const OrgHeader = "X-JMS-ORG"
+
+// RequestOption customizes a single request before it is sent.
+type RequestOption func(*http.Request)
+
+// WithOrg scopes a request to the JumpServer organization orgID. An empty
+// orgID leaves the request unchanged, so the transport's default applies.
+func WithOrg(orgID string) RequestOption {
+	return func(req *http.Request) {
+		if orgID != "" {
+			req.Header.Set(OrgHeader, orgID)
+		}
+	}
+}
+
+// IsStatus reports whether err is an API error with the given status code.
+func IsStatus(err error, statusCode int) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode() == statusCode
+}
+
+// IsNotFound reports whether err is an API error with status 404.
+func IsNotFound(err error) bool {
+	return IsStatus(err, http.StatusNotFound)
+}
+
+// Get sends a GET request. See Do.
+func (c *Client) Get(ctx context.Context, path string, body, out interface{}, opts ...RequestOption) error {
+	return c.Do(ctx, http.MethodGet, path, body, out, opts...)
+}
+
+// Post sends a POST request. See Do.
+func (c *Client) Post(ctx context.Context, path string, body, out interface{}, opts ...RequestOption) error {
+	return c.Do(ctx, http.MethodPost, path, body, out, opts...)
+}
+
+// Put sends a PUT request. See Do.
+func (c *Client) Put(ctx context.Context, path string, body, out interface{}, opts ...RequestOption) error {
+	return c.Do(ctx, http.MethodPut, path, body, out, opts...)
+}
+
+// Patch sends a PATCH request. See Do.
+func (c *Client) Patch(ctx context.Context, path string, body, out interface{}, opts ...RequestOption) error {
+	return c.Do(ctx, http.MethodPatch, path, body, out, opts...)
+}
+
+// Delete sends a DELETE request. See Do.
+func (c *Client) Delete(ctx context.Context, path string, body, out interface{}, opts ...RequestOption) error {
+	return c.Do(ctx, http.MethodDelete, path, body, out, opts...)
+}
+
+// Do sends a request to path, which is relative to the base URL and may
+// include a query string. body is JSON-encoded when it is not nil. A 2xx
+// response body is decoded into out when out is not nil and the body is not
+// empty; any other status is returned as an *Error. opts are applied to the
+// request last.
+func (c *Client) Do(ctx context.Context, method, path string, body, out interface{}, opts ...RequestOption) error {
+	_, err := c.DoStatus(ctx, method, path, body, out, opts...)
+	return err
+}
+
+// DoStatus is Do, and also returns the status code of the response, or 0
+// when no response was received.
+func (c *Client) DoStatus(ctx context.Context, method, path string, body, out interface{}, opts ...RequestOption) (int, error) {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return 0, err
+	}
+	httpReq.Header.Set("accept", "application/json")
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	for _, opt := range opts {
+		opt(httpReq)
+	}
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return httpResp.StatusCode, err
+	}
+	if httpResp.StatusCode < 200 || httpResp.StatusCode > 299 {
+		return httpResp.StatusCode, &Error{Response: httpResp, Body: respBody}
+	}
+
+	if out == nil || len(bytes.TrimSpace(respBody)) == 0 {
+		return httpResp.StatusCode, nil
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return httpResp.StatusCode, &DecodeError{Body: respBody, Err: err}
+	}
+	return httpResp.StatusCode, nil
+}
+
+// List sends a GET request to a list endpoint and decodes the results into
+// out, which must point to a slice. List endpoints return a plain array, or a
+// paginated {"count", "next", "results"} envelope when limit/offset are in
+// effect; both are accepted, and the next links of an envelope are followed
+// until every page has been read.
+func (c *Client) List(ctx context.Context, path string, out interface{}, opts ...RequestOption) error {
+	var items []json.RawMessage
+	seen := map[string]bool{}
+	for path != "" && !seen[path] {
+		seen[path] = true
+
+		var raw json.RawMessage
+		if err := c.Get(ctx, path, nil, &raw, opts...); err != nil {
+			return err
+		}
+		var page []json.RawMessage
+		if err := json.Unmarshal(raw, &page); err == nil {
+			items = append(items, page...)
+			break
+		}
+
+		var envelope struct {
+			Next    *string           `json:"next"`
+			Results []json.RawMessage `json:"results"`
+		}
+		if err := json.Unmarshal(raw, &envelope); err != nil {
+			return &DecodeError{Body: raw, Err: err}
+		}
+		items = append(items, envelope.Results...)
+
+		path = ""
+		if envelope.Next != nil && *envelope.Next != "" {
+			next, err := c.relativePath(*envelope.Next)
+			if err != nil {
+				return err
+			}
+			path = next
+		}
+	}
+
+	combined, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(combined, out); err != nil {
+		return &DecodeError{Body: combined, Err: err}
+	}
+	return nil
+}
+
+// relativePath turns the absolute next link of a paginated response back into
+// a path relative to the base URL.
+func (c *Client) relativePath(link string) (string, error) {
+	if strings.HasPrefix(link, c.baseURL) {
+		return strings.TrimPrefix(link, c.baseURL), nil
+	}
+	// JumpServer behind a proxy may report its own host in the link; keep
+	// only the path and query.
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return "", fmt.Errorf("invalid next page link %q: %w", link, err)
+	}
+	return parsed.RequestURI(), nil
+}
@@ -0,0 +1,213 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// newTestClient returns a Client for a server answering with handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return New(server.Client(), server.URL+"/")
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+type item struct {
+	ID string `json:"id"`
+}
+
+func TestListPlainArray(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, []item{{ID: "1"}, {ID: "2"}})
+	})
+
+	var items []item
+	if err := c.List(context.Background(), "/api/v1/assets/hosts/", &items); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if want := []item{{ID: "1"}, {ID: "2"}}; !reflect.DeepEqual(items, want) {
+		t.Errorf("List() = %v, want %v", items, want)
+	}
+}
+
+func TestListFollowsNextLinks(t *testing.T) {
+	var c *Client
+	var requests []string
+	c = newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RequestURI())
+		switch r.URL.Query().Get("offset") {
+		case "":
+			next := c.baseURL + "/api/v1/assets/hosts/?limit=1&offset=1"
+			writeJSON(w, http.StatusOK, map[string]interface{}{"count": 3, "next": next, "results": []item{{ID: "1"}}})
+		case "1":
+			// A proxy may report its own host in the link.
+			next := "https://proxy.example.com/api/v1/assets/hosts/?limit=1&offset=2"
+			writeJSON(w, http.StatusOK, map[string]interface{}{"count": 3, "next": next, "results": []item{{ID: "2"}}})
+		default:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"count": 3, "next": nil, "results": []item{{ID: "3"}}})
+		}
+	})
+
+	var items []item
+	if err := c.List(context.Background(), "/api/v1/assets/hosts/?limit=1", &items); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if want := []item{{ID: "1"}, {ID: "2"}, {ID: "3"}}; !reflect.DeepEqual(items, want) {
+		t.Errorf("List() = %v, want %v", items, want)
+	}
+	want := []string{
+		"/api/v1/assets/hosts/?limit=1",
+		"/api/v1/assets/hosts/?limit=1&offset=1",
+		"/api/v1/assets/hosts/?limit=1&offset=2",
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("requests = %v, want %v", requests, want)
+	}
+}
+
+func TestListStopsOnRepeatedNextLink(t *testing.T) {
+	var c *Client
+	calls := 0
+	c = newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		writeJSON(w, http.StatusOK, map[string]interface{}{"next": c.baseURL + r.URL.RequestURI(), "results": []item{{ID: "1"}}})
+	})
+
+	var items []item
+	if err := c.List(context.Background(), "/api/v1/assets/hosts/", &items); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if calls != 1 || len(items) != 1 {
+		t.Errorf("got %d requests and %d items, want 1 and 1", calls, len(items))
+	}
+}
+
+func TestListReturnsAPIErrors(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "" {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"next": "/api/v1/assets/hosts/?offset=1", "results": []item{{ID: "1"}}})
+			return
+		}
+		writeJSON(w, http.StatusInternalServerError, map[string]interface{}{"detail": "boom"})
+	})
+
+	var items []item
+	err := c.List(context.Background(), "/api/v1/assets/hosts/", &items)
+	if !IsStatus(err, http.StatusInternalServerError) {
+		t.Errorf("List() error = %v, want a 500 API error", err)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("<html>created</html>"))
+	})
+
+	var out map[string]interface{}
+	status, err := c.DoStatus(context.Background(), http.MethodPost, "/api/v1/assets/hosts/", map[string]string{"name": "web"}, &out)
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("DoStatus() error = %v, want a *DecodeError", err)
+	}
+	if status != http.StatusCreated {
+		t.Errorf("status = %d, want %d", status, http.StatusCreated)
+	}
+	if string(decodeErr.Body) != "<html>created</html>" {
+		t.Errorf("DecodeError.Body = %q", decodeErr.Body)
+	}
+	var syntaxErr *json.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Errorf("DecodeError does not unwrap to the JSON error: %v", err)
+	}
+	if IsNotFound(err) {
+		t.Error("IsNotFound() = true for a decode error")
+	}
+}
+
+func TestEmptyBodyIsNotDecoded(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	out := map[string]interface{}{"kept": true}
+	if err := c.Delete(context.Background(), "/api/v1/assets/hosts/1/", nil, &out); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if out["kept"] != true {
+		t.Errorf("out = %v, want it unchanged", out)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing/":
+			writeJSON(w, http.StatusNotFound, map[string]string{"detail": "Not found."})
+		default:
+			writeJSON(w, http.StatusForbidden, map[string]string{"detail": "denied"})
+		}
+	})
+
+	err := c.Get(context.Background(), "/missing/", nil, nil)
+	if !IsNotFound(err) {
+		t.Errorf("IsNotFound(%v) = false for a 404", err)
+	}
+	if !IsNotFound(fmt.Errorf("reading host: %w", err)) {
+		t.Error("IsNotFound() = false for a wrapped 404")
+	}
+
+	err = c.Get(context.Background(), "/forbidden/", nil, nil)
+	if IsNotFound(err) {
+		t.Errorf("IsNotFound(%v) = true for a 403", err)
+	}
+	if !IsStatus(err, http.StatusForbidden) {
+		t.Errorf("IsStatus(%v, 403) = false", err)
+	}
+	if IsNotFound(nil) || IsNotFound(errors.New("connection refused")) {
+		t.Error("IsNotFound() = true for an error without a response")
+	}
+}
+
+func TestErrorNamesRetryAfter(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		writeJSON(w, http.StatusTooManyRequests, map[string]string{"detail": "throttled"})
+	})
+
+	err := c.Get(context.Background(), "/api/v1/assets/hosts/", nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "retry after 30") {
+		t.Errorf("error = %v, want it to name the Retry-After delay", err)
+	}
+}
+
+func TestWithOrg(t *testing.T) {
+	var got []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get(OrgHeader))
+		writeJSON(w, http.StatusOK, map[string]string{})
+	})
+
+	for _, orgID := range []string{"00000002", ""} {
+		if err := c.Get(context.Background(), "/api/v1/orgs/", nil, nil, WithOrg(orgID)); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+	}
+	if want := []string{"00000002", ""}; !reflect.DeepEqual(got, want) {
+		t.Errorf("org headers = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,103 @@
+// Package compat holds JumpServer's protocol compatibility tables: the port
+// each protocol defaults to, the protocols each platform category accepts and
+// the categories whose assets carry cloud metadata.
+//
+// Validators and plan modifiers in the provider read these tables so they
+// agree on one source of truth. The API still has the final say on whether a
+// protocol is valid for a particular platform.
+package compat
+
+import (
+	"sort"
+	"strings"
+)
+
+// defaultPorts holds the port JumpServer assigns to each protocol when an
+// asset does not specify one.
+var defaultPorts = map[string]int64{
+	"ssh":        22,
+	"sftp":       22,
+	"rdp":        3389,
+	"telnet":     23,
+	"vnc":        5900,
+	"winrm":      5985,
+	"mysql":      3306,
+	"mariadb":    3306,
+	"postgresql": 5432,
+	"oracle":     1521,
+	"sqlserver":  1433,
+	"db2":        50000,
+	"dameng":     5236,
+	"clickhouse": 9000,
+	"mongodb":    27017,
+	"redis":      6379,
+	"k8s":        443,
+	"http":       80,
+	"https":      443,
+	"chatgpt":    443,
+}
+
+// categoryProtocols lists the protocols allowed for each platform category.
+// Platforms in the "custom" category accept any protocol.
+var categoryProtocols = map[string][]string{
+	"host":     {"ssh", "sftp", "rdp", "telnet", "vnc", "winrm"},
+	"device":   {"ssh", "sftp", "telnet"},
+	"database": {"mysql", "mariadb", "postgresql", "oracle", "sqlserver", "db2", "dameng", "clickhouse", "mongodb", "redis"},
+	"cloud":    {"k8s"},
+	"web":      {"http", "https"},
+	"gpt":      {"chatgpt"},
+}
+
+// cloudCategories are the platform categories whose assets carry cloud
+// metadata such as a region.
+var cloudCategories = map[string]bool{
+	"cloud": true,
+}
+
+// DefaultPort returns the conventional port for a protocol name. Names are
+// matched case-insensitively.
+func DefaultPort(name string) (int64, bool) {
+	port, ok := defaultPorts[strings.ToLower(name)]
+	return port, ok
+}
+
+// PlatformSupportsProtocol reports whether a platform category accepts the
+// protocol. Unknown categories accept nothing; "custom" accepts everything.
+func PlatformSupportsProtocol(category, proto string) bool {
+	category = strings.ToLower(category)
+	if category == "custom" {
+		return true
+	}
+
+	proto = strings.ToLower(proto)
+	for _, name := range categoryProtocols[category] {
+		if name == proto {
+			return true
+		}
+	}
+	return false
+}
+
+// KnownCategory reports whether the protocol table covers a platform
+// category, i.e. whether PlatformSupportsProtocol can tell for it.
+func KnownCategory(category string) bool {
+	category = strings.ToLower(category)
+	_, ok := categoryProtocols[category]
+	return ok || category == "custom"
+}
+
+// IsCloudCategory reports whether assets of a platform category carry cloud
+// metadata such as a region.
+func IsCloudCategory(category string) bool {
+	return cloudCategories[strings.ToLower(category)]
+}
+
+// ProtocolNames returns every protocol with a known default port, sorted.
+func ProtocolNames() []string {
+	names := make([]string, 0, len(defaultPorts))
+	for name := range defaultPorts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
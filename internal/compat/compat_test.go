@@ -0,0 +1,107 @@
+package compat
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestDefaultPort(t *testing.T) {
+	tests := []struct {
+		name     string
+		protocol string
+		wantPort int64
+		wantOK   bool
+	}{
+		{name: "ssh", protocol: "ssh", wantPort: 22, wantOK: true},
+		{name: "rdp", protocol: "rdp", wantPort: 3389, wantOK: true},
+		{name: "database", protocol: "postgresql", wantPort: 5432, wantOK: true},
+		{name: "web", protocol: "https", wantPort: 443, wantOK: true},
+		{name: "case insensitive", protocol: "SSH", wantPort: 22, wantOK: true},
+		{name: "unknown", protocol: "sssh", wantOK: false},
+		{name: "empty", protocol: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			port, ok := DefaultPort(tt.protocol)
+			if ok != tt.wantOK || port != tt.wantPort {
+				t.Errorf("DefaultPort(%q) = %d, %t; want %d, %t", tt.protocol, port, ok, tt.wantPort, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestPlatformSupportsProtocol(t *testing.T) {
+	tests := []struct {
+		name     string
+		category string
+		protocol string
+		want     bool
+	}{
+		{name: "host ssh", category: "host", protocol: "ssh", want: true},
+		{name: "host rdp", category: "host", protocol: "rdp", want: true},
+		{name: "host mysql", category: "host", protocol: "mysql", want: false},
+		{name: "device telnet", category: "device", protocol: "telnet", want: true},
+		{name: "device rdp", category: "device", protocol: "rdp", want: false},
+		{name: "database redis", category: "database", protocol: "redis", want: true},
+		{name: "database ssh", category: "database", protocol: "ssh", want: false},
+		{name: "cloud k8s", category: "cloud", protocol: "k8s", want: true},
+		{name: "web http", category: "web", protocol: "http", want: true},
+		{name: "gpt chatgpt", category: "gpt", protocol: "chatgpt", want: true},
+		{name: "custom accepts anything", category: "custom", protocol: "my-protocol", want: true},
+		{name: "unknown category", category: "mainframe", protocol: "ssh", want: false},
+		{name: "case insensitive", category: "Host", protocol: "SSH", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PlatformSupportsProtocol(tt.category, tt.protocol); got != tt.want {
+				t.Errorf("PlatformSupportsProtocol(%q, %q) = %t; want %t", tt.category, tt.protocol, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKnownCategory(t *testing.T) {
+	for category, want := range map[string]bool{"host": true, "Database": true, "custom": true, "mainframe": false, "": false} {
+		if got := KnownCategory(category); got != want {
+			t.Errorf("KnownCategory(%q) = %t; want %t", category, got, want)
+		}
+	}
+}
+
+func TestIsCloudCategory(t *testing.T) {
+	for category, want := range map[string]bool{"cloud": true, "Cloud": true, "host": false, "custom": false} {
+		if got := IsCloudCategory(category); got != want {
+			t.Errorf("IsCloudCategory(%q) = %t; want %t", category, got, want)
+		}
+	}
+}
+
+func TestCloudCategoriesHaveProtocols(t *testing.T) {
+	for category := range cloudCategories {
+		if !KnownCategory(category) {
+			t.Errorf("cloud category %q has no protocol table", category)
+		}
+	}
+}
+
+func TestCategoryProtocolsHaveDefaultPorts(t *testing.T) {
+	for category, protocols := range categoryProtocols {
+		for _, proto := range protocols {
+			if _, ok := DefaultPort(proto); !ok {
+				t.Errorf("protocol %q of category %q has no default port", proto, category)
+			}
+		}
+	}
+}
+
+func TestProtocolNames(t *testing.T) {
+	names := ProtocolNames()
+	if len(names) != len(defaultPorts) {
+		t.Fatalf("ProtocolNames() returned %d names; want %d", len(names), len(defaultPorts))
+	}
+	if !sort.StringsAreSorted(names) {
+		t.Errorf("ProtocolNames() is not sorted: %v", names)
+	}
+}
@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-jumpserver/internal/client"
+	"terraform-provider-jumpserver/internal/compat"
+)
+
+// jumpServerClient is the provider data handed to every resource and data
+// source. It wraps the JumpServer API client and carries provider-wide
+// settings and caches.
+type jumpServerClient struct {
+	// api sends JSON requests through the authenticated *http.Client.
+	api *client.Client
+
+	// validateProtocolsFromAPI enables checking protocol names against the
+	// protocols the asset's platform allows, as reported by the API.
+	validateProtocolsFromAPI bool
+
+	// defaultPlatform is used by asset resources whose platform is not set.
+	defaultPlatform string
+
+	// maxConcurrency caps the requests a data source sends in parallel when
+	// it reads many objects.
+	maxConcurrency int
+
+	// apiVersion is the JumpServer version detected at configure time, or ""
+	// when the server does not report it.
+	apiVersion string
+
+	// lookups caches node and platform resolutions for the lifetime of the
+	// provider process, i.e. a single plan or apply. mu guards the map.
+	mu      sync.Mutex
+	lookups map[string]*lookupEntry
+}
+
+// lookupEntry holds one cached resolution. once ensures concurrent callers
+// asking for the same key share a single API call.
+type lookupEntry struct {
+	once  sync.Once
+	value interface{}
+	err   error
+}
+
+func newJumpServerClient(httpClient *http.Client, baseURL string) *jumpServerClient {
+	return &jumpServerClient{
+		api:            client.New(httpClient, baseURL),
+		maxConcurrency: defaultMaxConcurrency,
+		lookups:        map[string]*lookupEntry{},
+	}
+}
+
+// orgOption scopes a request to a resource's org_id, or to the provider
+// default organization when org_id is not set.
+func orgOption(orgID types.String) client.RequestOption {
+	return client.WithOrg(orgID.ValueString())
+}
+
+// resolveCached returns the cached result for kind/key, calling resolve at
+// most once for all concurrent callers. Failed lookups are evicted so a
+// later call can retry them.
+func (c *jumpServerClient) resolveCached(kind, key string, resolve func() (interface{}, error)) (interface{}, error) {
+	cacheKey := kind + ":" + key
+
+	c.mu.Lock()
+	entry, ok := c.lookups[cacheKey]
+	if !ok {
+		entry = &lookupEntry{}
+		c.lookups[cacheKey] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.value, entry.err = resolve()
+	})
+
+	if entry.err != nil {
+		c.mu.Lock()
+		if c.lookups[cacheKey] == entry {
+			delete(c.lookups, cacheKey)
+		}
+		c.mu.Unlock()
+	}
+	return entry.value, entry.err
+}
+
+// resolveCachedString is resolveCached for lookups that resolve to a string.
+func (c *jumpServerClient) resolveCachedString(kind, key string, resolve func() (string, error)) (string, error) {
+	value, err := c.resolveCached(kind, key, func() (interface{}, error) {
+		return resolve()
+	})
+	if err != nil {
+		return "", err
+	}
+	return value.(string), nil
+}
+
+// allowedProtocols returns the protocol names the platform accepts. The API
+// result is cached per platform; if the lookup fails, the static list of
+// known protocols is returned and the failure is not cached.
+func (c *jumpServerClient) allowedProtocols(ctx context.Context, platform string) []string {
+	names, err := c.resolveCached("platform-protocols", platform, func() (interface{}, error) {
+		return c.fetchPlatformProtocols(ctx, platform)
+	})
+	if err != nil {
+		return compat.ProtocolNames()
+	}
+	return names.([]string)
+}
+
+func (c *jumpServerClient) fetchPlatformProtocols(ctx context.Context, platform string) ([]string, error) {
+	detail, err := c.fetchPlatform(ctx, platform)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(detail.Protocols))
+	for _, proto := range detail.Protocols {
+		names = append(names, proto.Name)
+	}
+	return names, nil
+}
+
+// platformCategory returns the category ("host", "cloud", ...) of a platform.
+func (c *jumpServerClient) platformCategory(ctx context.Context, platform string) (string, error) {
+	return c.resolveCachedString("platform-category", platform, func() (string, error) {
+		detail, err := c.fetchPlatform(ctx, platform)
+		if err != nil {
+			return "", err
+		}
+		return detail.Category.Value, nil
+	})
+}
+
+// platformDetail is the subset of a platform the provider uses.
+type platformDetail struct {
+	Category  choiceField `json:"category"`
+	Protocols []struct {
+		Name string `json:"name"`
+	} `json:"protocols"`
+}
+
+// choiceField decodes JumpServer choice fields, which are returned either as
+// a plain string or as a {"value": ..., "label": ...} object.
+type choiceField struct {
+	Value string
+}
+
+func (f *choiceField) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &f.Value); err == nil {
+		return nil
+	}
+	var choice struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(data, &choice); err != nil {
+		return err
+	}
+	f.Value = choice.Value
+	return nil
+}
+
+func (c *jumpServerClient) fetchPlatform(ctx context.Context, platform string) (*platformDetail, error) {
+	platformID, err := c.resolvePlatformID(ctx, platform)
+	if err != nil {
+		return nil, err
+	}
+
+	var detail platformDetail
+	if err := c.api.Get(ctx, fmt.Sprintf("/api/v1/assets/platforms/%s/", platformID), nil, &detail); err != nil {
+		return nil, err
+	}
+	return &detail, nil
+}
+
+// resolvePlatformID returns the ID of a platform given either its numeric ID
+// or its name. Names are looked up once per provider process; a name that
+// matches no platform, or more than one, is an error.
+func (c *jumpServerClient) resolvePlatformID(ctx context.Context, platform string) (string, error) {
+	if _, err := strconv.ParseInt(platform, 10, 64); err == nil {
+		return platform, nil
+	}
+
+	return c.resolveCachedString("platform-id", platform, func() (string, error) {
+		platforms, err := c.listPlatforms(ctx, url.Values{"name": {platform}})
+		if err != nil {
+			return "", err
+		}
+
+		// The name filter may match loosely, so only count exact matches.
+		var ids []string
+		for _, p := range platforms {
+			if p.Name == platform {
+				ids = append(ids, p.ID.String())
+			}
+		}
+		switch len(ids) {
+		case 0:
+			return "", fmt.Errorf("no platform named %q found", platform)
+		case 1:
+			return ids[0], nil
+		default:
+			return "", fmt.Errorf("%d platforms named %q found (IDs %s), use the platform ID instead", len(ids), platform, strings.Join(ids, ", "))
+		}
+	})
+}
+
+// resolveNodeID returns the ID of the node with the given full path, e.g.
+// "/Default/Web", in organization orgID ("" for the provider default). Paths
+// are looked up once per provider process; a path that matches no node, or
+// more than one, is an error.
+func (c *jumpServerClient) resolveNodeID(ctx context.Context, orgID, fullValue string) (string, error) {
+	return c.resolveCachedString("node-id", orgID+":"+fullValue, func() (string, error) {
+		value := fullValue[strings.LastIndex(fullValue, "/")+1:]
+		nodes, err := c.listNodes(ctx, url.Values{"value": {value}}, client.WithOrg(orgID))
+		if err != nil {
+			return "", err
+		}
+
+		// Nodes with the same name may exist under different parents.
+		var ids []string
+		for _, node := range nodes {
+			if node.FullValue == fullValue {
+				ids = append(ids, node.ID)
+			}
+		}
+		switch len(ids) {
+		case 0:
+			return "", fmt.Errorf("no node with path %q found", fullValue)
+		case 1:
+			return ids[0], nil
+		default:
+			return "", fmt.Errorf("%d nodes with path %q found (IDs %s)", len(ids), fullValue, strings.Join(ids, ", "))
+		}
+	})
+}
+
+// taskPollInterval is how often waitForTask checks a task's state.
+const taskPollInterval = time.Second
+
+// waitForTask polls the Celery task taskID until it finishes. It returns an
+// error when the task fails or ctx is done first, so callers bound the wait
+// with a context deadline.
+func (c *jumpServerClient) waitForTask(ctx context.Context, taskID string) error {
+	if taskID == "" {
+		return fmt.Errorf("JumpServer did not return a task ID")
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/ops/celery/task/%s/result/", taskID)
+	for {
+		var result struct {
+			Status string `json:"status"`
+			Result string `json:"result"`
+		}
+		if err := c.api.Get(ctx, apiPath, nil, &result); err != nil {
+			return err
+		}
+		switch strings.ToUpper(result.Status) {
+		case "SUCCESS":
+			return nil
+		case "FAILURE", "REVOKED":
+			return fmt.Errorf("task %s ended with status %s: %s", taskID, result.Status, result.Result)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("task %s still %s: %w", taskID, strings.ToLower(result.Status), ctx.Err())
+		case <-time.After(taskPollInterval):
+		}
+	}
+}
+
+// versionEndpoints are queried in order until one reports a version.
+var versionEndpoints = []string{
+	"/api/health/",
+	"/api/v1/settings/public/",
+}
+
+// versionDetectTimeout bounds version detection as a whole, independently of
+// request_timeout, so a server without the endpoints does not slow down
+// configure.
+const versionDetectTimeout = 5 * time.Second
+
+// detectAPIVersion returns the JumpServer version, or "" when no endpoint
+// reports one. Errors are logged and otherwise ignored.
+func (c *jumpServerClient) detectAPIVersion(ctx context.Context) string {
+	ctx, cancel := context.WithTimeout(ctx, versionDetectTimeout)
+	defer cancel()
+
+	for _, apiPath := range versionEndpoints {
+		var result map[string]interface{}
+		if err := c.api.Get(ctx, apiPath, nil, &result); err != nil {
+			tflog.Debug(ctx, "Unable to query JumpServer version", map[string]interface{}{"path": apiPath, "error": err.Error()})
+			continue
+		}
+
+		for _, key := range []string{"version", "VERSION"} {
+			if version, ok := result[key].(string); ok && version != "" {
+				return version
+			}
+		}
+	}
+	return ""
+}
+
+// version returns the JumpServer version detected at configure time, e.g.
+// "v3.10.7", or "" when it is not known.
+func (c *jumpServerClient) version() string {
+	return c.apiVersion
+}
+
+// majorVersion returns the major JumpServer version, or 0 when the version is
+// not known or not numeric (e.g. "dev").
+func (c *jumpServerClient) majorVersion() int {
+	major, _, _ := strings.Cut(strings.TrimPrefix(strings.ToLower(c.version()), "v"), ".")
+	n, err := strconv.Atoi(major)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// platformRef returns the platform field of an asset payload. JumpServer v4
+// takes related objects as {"pk": ...}; earlier versions, and servers whose
+// version is unknown, take the plain ID.
+func (c *jumpServerClient) platformRef(platformID string) interface{} {
+	if c.majorVersion() >= 4 {
+		return map[string]interface{}{"pk": platformID}
+	}
+	return platformID
+}
+
+// isCreateStatus reports whether statusCode is what the server answers a
+// successful create with. JumpServer v3 and later reply 201; some earlier
+// versions reply 200, so both are accepted when the version is not known.
+func (c *jumpServerClient) isCreateStatus(statusCode int) bool {
+	switch major := c.majorVersion(); {
+	case major >= 3:
+		return statusCode == http.StatusCreated
+	case major > 0:
+		return statusCode == http.StatusOK
+	default:
+		return statusCode == http.StatusOK || statusCode == http.StatusCreated
+	}
+}
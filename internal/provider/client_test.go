@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestResolveCached(t *testing.T) {
+	c := newJumpServerClient(http.DefaultClient, "http://jumpserver.invalid")
+
+	var calls int32
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := c.resolveCached("node-id", "/Default", func() (interface{}, error) {
+				atomic.AddInt32(&calls, 1)
+				return "node-1", nil
+			})
+			if err != nil || value != "node-1" {
+				t.Errorf("resolveCached() = %v, %v; want node-1, nil", value, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("resolver called %d times, want 1", calls)
+	}
+}
+
+func TestResolveCachedRetriesFailures(t *testing.T) {
+	c := newJumpServerClient(http.DefaultClient, "http://jumpserver.invalid")
+
+	calls := 0
+	resolve := func() (interface{}, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("unavailable")
+		}
+		return "1", nil
+	}
+	if _, err := c.resolveCached("platform-id", "Linux", resolve); err == nil {
+		t.Fatal("first resolveCached() succeeded, want the resolver error")
+	}
+	if value, err := c.resolveCached("platform-id", "Linux", resolve); err != nil || value != "1" {
+		t.Fatalf("second resolveCached() = %v, %v; want 1, nil", value, err)
+	}
+	if _, err := c.resolveCached("platform-id", "Linux", resolve); err != nil || calls != 2 {
+		t.Errorf("resolver called %d times, want 2", calls)
+	}
+}
+
+func TestResolveNodeIDLooksUpOnce(t *testing.T) {
+	server := newTestServer(t)
+	server.put("/api/v1/assets/nodes/", map[string]interface{}{"id": "7f8c2a9e-7b4f-4a51-9d8e-0c6a1b2f3d41", "value": "Web", "full_value": "/Default/Web"})
+	server.put("/api/v1/assets/nodes/", map[string]interface{}{"id": "2b0d5c3e-1f7a-4e9b-8c6d-5a4b3c2d1e0f", "value": "Web", "full_value": "/Other/Web"})
+	c := newTestClient(server)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := c.resolveNodeID(context.Background(), "", "/Default/Web")
+			if err != nil || id != "7f8c2a9e-7b4f-4a51-9d8e-0c6a1b2f3d41" {
+				t.Errorf("resolveNodeID() = %q, %v", id, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := len(server.requestsTo(http.MethodGet, "/api/v1/assets/nodes/")); got != 1 {
+		t.Errorf("%d node lookups, want 1", got)
+	}
+	if _, err := c.resolveNodeID(context.Background(), "", "/Default/Missing"); err == nil {
+		t.Error("resolveNodeID() of a missing node succeeded")
+	}
+}
+
+func TestAllowedProtocolsLooksUpOnce(t *testing.T) {
+	server := newTestServer(t)
+	c := newTestClient(server)
+
+	for i := 0; i < 3; i++ {
+		names := c.allowedProtocols(context.Background(), "Linux")
+		sort.Strings(names)
+		if len(names) != 2 || names[0] != "sftp" || names[1] != "ssh" {
+			t.Fatalf("allowedProtocols() = %v, want [sftp ssh]", names)
+		}
+	}
+	if got := len(server.requestsTo(http.MethodGet, "/api/v1/assets/platforms/")); got != 1 {
+		t.Errorf("%d platform name lookups, want 1", got)
+	}
+	if got := len(server.requestsTo(http.MethodGet, "/api/v1/assets/platforms/"+testPlatformLinux+"/")); got != 1 {
+		t.Errorf("%d platform detail requests, want 1", got)
+	}
+}
+
+// handleTask serves the result endpoint of task id, reporting each of
+// statuses in turn and the last one from then on.
+func handleTask(server *testServer, id string, statuses ...string) {
+	var mu sync.Mutex
+	server.handle(http.MethodGet, "/api/v1/ops/celery/task/"+id+"/result/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		status := statuses[0]
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
+		mu.Unlock()
+		writeTestJSON(w, http.StatusOK, map[string]interface{}{"id": id, "status": status, "result": ""})
+	})
+}
+
+func TestWaitForTask(t *testing.T) {
+	server := newTestServer(t)
+	handleTask(server, "done", "PENDING", "SUCCESS")
+	handleTask(server, "failed", "FAILURE")
+	handleTask(server, "stuck", "PENDING")
+	c := newTestClient(server)
+
+	if err := c.waitForTask(context.Background(), "done"); err != nil {
+		t.Errorf("waitForTask(done) error = %v", err)
+	}
+	if err := c.waitForTask(context.Background(), "failed"); err == nil {
+		t.Error("waitForTask(failed) succeeded, want an error")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := c.waitForTask(ctx, "stuck"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("waitForTask(stuck) error = %v, want the context deadline", err)
+	}
+}
+
+func TestDetectAPIVersion(t *testing.T) {
+	server := newTestServer(t)
+	// The health endpoint is missing, so the public settings are queried next.
+	server.handle(http.MethodGet, "/api/health/", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusNotFound, map[string]interface{}{"detail": "Not found."})
+	})
+	server.handle(http.MethodGet, "/api/v1/settings/public/", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, map[string]interface{}{"VERSION": "v4.0.1"})
+	})
+
+	if got := newTestClient(server).detectAPIVersion(context.Background()); got != "v4.0.1" {
+		t.Errorf("detectAPIVersion() = %q, want v4.0.1", got)
+	}
+}
+
+func TestVersionAwarePayloads(t *testing.T) {
+	tests := []struct {
+		version     string
+		major       int
+		platformRef interface{}
+		create      []int
+	}{
+		{version: "", major: 0, platformRef: "1", create: []int{http.StatusOK, http.StatusCreated}},
+		{version: "dev", major: 0, platformRef: "1", create: []int{http.StatusOK, http.StatusCreated}},
+		{version: "2.28.0", major: 2, platformRef: "1", create: []int{http.StatusOK}},
+		{version: "v3.10.7", major: 3, platformRef: "1", create: []int{http.StatusCreated}},
+		{version: "v4.0.1", major: 4, platformRef: map[string]interface{}{"pk": "1"}, create: []int{http.StatusCreated}},
+	}
+	for _, tt := range tests {
+		c := newJumpServerClient(http.DefaultClient, "http://jumpserver.invalid")
+		c.apiVersion = tt.version
+
+		if got := c.majorVersion(); got != tt.major {
+			t.Errorf("%q: majorVersion() = %d, want %d", tt.version, got, tt.major)
+		}
+		if got := c.platformRef("1"); !reflect.DeepEqual(got, tt.platformRef) {
+			t.Errorf("%q: platformRef() = %v, want %v", tt.version, got, tt.platformRef)
+		}
+		var accepted []int
+		for _, status := range []int{http.StatusOK, http.StatusCreated, http.StatusAccepted} {
+			if c.isCreateStatus(status) {
+				accepted = append(accepted, status)
+			}
+		}
+		if !reflect.DeepEqual(accepted, tt.create) {
+			t.Errorf("%q: accepted create statuses %v, want %v", tt.version, accepted, tt.create)
+		}
+	}
+}
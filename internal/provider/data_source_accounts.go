@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the data source implements the required interfaces.
+var _ datasource.DataSource = &AccountsDataSource{}
+
+// AccountsDataSource defines the data source implementation.
+type AccountsDataSource struct {
+	client *jumpServerClient
+}
+
+// AccountsDataSourceModel describes the data source data model.
+type AccountsDataSourceModel struct {
+	Username   types.String   `tfsdk:"username"`
+	Asset      types.String   `tfsdk:"asset"`
+	Privileged types.Bool     `tfsdk:"privileged"`
+	Search     types.String   `tfsdk:"search"`
+	Results    []AccountModel `tfsdk:"results"`
+}
+
+// AccountModel describes a single account.
+type AccountModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Username   types.String `tfsdk:"username"`
+	Privileged types.Bool   `tfsdk:"privileged"`
+	IsActive   types.Bool   `tfsdk:"is_active"`
+}
+
+func NewAccountsDataSource() datasource.DataSource {
+	return &AccountsDataSource{}
+}
+
+func (d *AccountsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_accounts"
+}
+
+func (d *AccountsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the accounts on assets, e.g. to look up account IDs for an asset permission.",
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Description: "Only return accounts with this username.",
+				Optional:    true,
+			},
+			"asset": schema.StringAttribute{
+				Description: "Only return accounts on the asset with this ID.",
+				Optional:    true,
+			},
+			"privileged": schema.BoolAttribute{
+				Description: "Only return privileged (true) or unprivileged (false) accounts.",
+				Optional:    true,
+			},
+			"search": schema.StringAttribute{
+				Description: "Only return accounts matching this search term.",
+				Optional:    true,
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "The list of accounts.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the account.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the account.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "The username of the account.",
+							Computed:    true,
+						},
+						"privileged": schema.BoolAttribute{
+							Description: "Whether the account is privileged.",
+							Computed:    true,
+						},
+						"is_active": schema.BoolAttribute{
+							Description: "Whether the account is active.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AccountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccountsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build query parameters
+	queryParams := url.Values{}
+	if !data.Username.IsNull() {
+		queryParams.Add("username", data.Username.ValueString())
+	}
+	if !data.Asset.IsNull() {
+		queryParams.Add("asset", data.Asset.ValueString())
+	}
+	if !data.Privileged.IsNull() {
+		queryParams.Add("privileged", strconv.FormatBool(data.Privileged.ValueBool()))
+	}
+	if !data.Search.IsNull() {
+		queryParams.Add("search", data.Search.ValueString())
+	}
+
+	accounts, err := d.client.listAccounts(ctx, queryParams)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read accounts", "account view", nil)
+		return
+	}
+
+	// Map the API response to the Terraform data model
+	data.Results = make([]AccountModel, 0, len(accounts))
+	for _, account := range accounts {
+		data.Results = append(data.Results, AccountModel{
+			ID:         types.StringValue(account.ID),
+			Name:       types.StringValue(account.Name),
+			Username:   types.StringValue(account.Username),
+			Privileged: types.BoolValue(account.Privileged),
+			IsActive:   types.BoolValue(account.IsActive),
+		})
+	}
+
+	// Set the data model as the response
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
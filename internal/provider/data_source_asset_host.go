@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+// Ensure the data source implements the required interfaces.
+var _ datasource.DataSource = &AssetHostDataSource{}
+
+// AssetHostDataSource defines the data source implementation.
+type AssetHostDataSource struct {
+	client *jumpServerClient
+}
+
+// AssetHostDataSourceModel describes the data source data model.
+type AssetHostDataSourceModel struct {
+	ID        types.String    `tfsdk:"id"`
+	Name      types.String    `tfsdk:"name"`
+	Address   types.String    `tfsdk:"address"`
+	Platform  types.String    `tfsdk:"platform"`
+	Nodes     []types.String  `tfsdk:"nodes"`
+	Protocols []ProtocolModel `tfsdk:"protocols"`
+	IsActive  types.Bool      `tfsdk:"is_active"`
+	Comment   types.String    `tfsdk:"comment"`
+	Domain    types.String    `tfsdk:"domain"`
+}
+
+func NewAssetHostDataSource() datasource.DataSource {
+	return &AssetHostDataSource{}
+}
+
+func (d *AssetHostDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_host"
+}
+
+func (d *AssetHostDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a single asset host by ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the asset host.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the asset host.",
+				Computed:    true,
+			},
+			"address": schema.StringAttribute{
+				Description: "The IP address or hostname of the asset host.",
+				Computed:    true,
+			},
+			"platform": schema.StringAttribute{
+				Description: "The ID of the platform of the asset host.",
+				Computed:    true,
+			},
+			"nodes": schema.ListAttribute{
+				Description: "The IDs of the nodes the asset host belongs to.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"protocols": schema.ListNestedAttribute{
+				Description: "The protocols of the asset host.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The protocol name.",
+							Computed:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "The port of the protocol.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the asset host is active.",
+				Computed:    true,
+			},
+			"comment": schema.StringAttribute{
+				Description: "The comment on the asset host.",
+				Computed:    true,
+			},
+			"domain": schema.StringAttribute{
+				Description: "The ID of the domain of the asset host. Null when the host is not in a domain.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *AssetHostDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AssetHostDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AssetHostDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.getHost(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Asset Host Not Found",
+			fmt.Sprintf("No asset host with ID %q exists in JumpServer.", data.ID.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read asset hosts", "asset view", nil)
+		return
+	}
+
+	// Map the API response to the Terraform data model. Missing or null
+	// fields are left null rather than failing the read.
+	data.Name = types.StringNull()
+	if name, ok := decodeStringField(&resp.Diagnostics, result, "name"); ok {
+		data.Name = types.StringValue(name)
+	}
+	data.Address = types.StringNull()
+	if address, ok := decodeStringField(&resp.Diagnostics, result, "address"); ok {
+		data.Address = types.StringValue(address)
+	}
+	data.Platform = types.StringNull()
+	if platform, ok := decodePlatformField(&resp.Diagnostics, result); ok {
+		data.Platform = types.StringValue(platform)
+	}
+	data.Comment = types.StringNull()
+	if comment, ok := decodeStringField(&resp.Diagnostics, result, "comment"); ok {
+		data.Comment = types.StringValue(comment)
+	}
+	data.IsActive = types.BoolNull()
+	if isActive, ok := result["is_active"].(bool); ok {
+		data.IsActive = types.BoolValue(isActive)
+	}
+	data.Domain = types.StringNull()
+	if domain := refID(result["domain"]); domain != "" {
+		data.Domain = types.StringValue(domain)
+	}
+
+	nodes, _ := decodeListField(&resp.Diagnostics, result, "nodes")
+	data.Nodes = make([]types.String, 0, len(nodes))
+	for _, node := range nodes {
+		if id := refID(node); id != "" {
+			data.Nodes = append(data.Nodes, types.StringValue(id))
+		}
+	}
+	protocols, _ := decodeListField(&resp.Diagnostics, result, "protocols")
+	data.Protocols = flattenHostProtocols(nil, protocols)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the data model as the response
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// refID returns the ID of a reference returned either as an ID string or as
+// an {"id": ..., "name": ...} object, or "" for anything else.
+func refID(raw interface{}) string {
+	switch ref := raw.(type) {
+	case string:
+		return ref
+	case map[string]interface{}:
+		if id, ok := ref["id"].(string); ok {
+			return id
+		}
+	}
+	return ""
+}
@@ -2,14 +2,16 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"net/http"
 	"net/url"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the data source implements the required interfaces.
@@ -17,7 +19,7 @@ var _ datasource.DataSource = &HostSuggestionsDataSource{}
 
 // HostSuggestionsDataSource defines the data source implementation.
 type HostSuggestionsDataSource struct {
-	client *http.Client
+	client *jumpServerClient
 }
 
 // HostSuggestionsDataSourceModel describes the data source data model.
@@ -44,6 +46,7 @@ type HostSuggestionsDataSourceModel struct {
 	Order                 types.String `tfsdk:"order"`
 	Limit                 types.Int64  `tfsdk:"limit"`
 	Offset                types.Int64  `tfsdk:"offset"`
+	Details               types.Bool   `tfsdk:"details"`
 	Results               []HostModel  `tfsdk:"results"`
 	TotalCount            types.Int64  `tfsdk:"total_count"`
 	Next                  types.String `tfsdk:"next"`
@@ -54,7 +57,14 @@ type HostSuggestionsDataSourceModel struct {
 type HostModel struct {
 	ID   types.String `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
-	// Add other fields as needed based on the Host schema in the API.
+
+	// Detail fields, only set when details is true.
+	Address   types.String    `tfsdk:"address"`
+	Platform  types.String    `tfsdk:"platform"`
+	Nodes     []types.String  `tfsdk:"nodes"`
+	Protocols []ProtocolModel `tfsdk:"protocols"`
+	IsActive  types.Bool      `tfsdk:"is_active"`
+	Comment   types.String    `tfsdk:"comment"`
 }
 
 func NewHostSuggestionsDataSource() datasource.DataSource {
@@ -157,6 +167,11 @@ func (d *HostSuggestionsDataSource) Schema(ctx context.Context, req datasource.S
 				Description: "The initial index from which to return the results.",
 				Optional:    true,
 			},
+			"details": schema.BoolAttribute{
+				Description: "Also fetch the full details of every result: address, platform, nodes, protocols, " +
+					"is_active and comment. Details are fetched in parallel, up to the provider's `max_concurrency` requests at a time.",
+				Optional: true,
+			},
 			"results": schema.ListNestedAttribute{
 				Description: "The list of host suggestions.",
 				Computed:    true,
@@ -170,7 +185,43 @@ func (d *HostSuggestionsDataSource) Schema(ctx context.Context, req datasource.S
 							Description: "The name of the host.",
 							Computed:    true,
 						},
-						// Add other fields as needed.
+						"address": schema.StringAttribute{
+							Description: "The address of the host. Only set when `details` is true.",
+							Computed:    true,
+						},
+						"platform": schema.StringAttribute{
+							Description: "The ID of the platform of the host. Only set when `details` is true.",
+							Computed:    true,
+						},
+						"nodes": schema.ListAttribute{
+							Description: "The IDs of the nodes the host belongs to. Only set when `details` is true.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"protocols": schema.ListNestedAttribute{
+							Description: "The protocols of the host. Only set when `details` is true.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "The protocol name.",
+										Computed:    true,
+									},
+									"port": schema.Int64Attribute{
+										Description: "The port of the protocol.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"is_active": schema.BoolAttribute{
+							Description: "Whether the host is active. Only set when `details` is true.",
+							Computed:    true,
+						},
+						"comment": schema.StringAttribute{
+							Description: "The comment on the host. Only set when `details` is true.",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -195,11 +246,11 @@ func (d *HostSuggestionsDataSource) Configure(ctx context.Context, req datasourc
 		return
 	}
 
-	client, ok := req.ProviderData.(*http.Client)
+	client, ok := req.ProviderData.(*jumpServerClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -285,68 +336,129 @@ func (d *HostSuggestionsDataSource) Read(ctx context.Context, req datasource.Rea
 		queryParams.Add("offset", fmt.Sprintf("%d", data.Offset.ValueInt64()))
 	}
 
-	// Build the full URL with query parameters
-	apiPath := "/api/v1/assets/hosts/suggestions/"
-	fullURL := fmt.Sprintf("%s%s?%s", d.client.Transport.(*authTransport).BaseURL, apiPath, queryParams.Encode())
-
-	// Send the HTTP GET request
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to create HTTP request",
-			fmt.Sprintf("Error: %s", err),
-		)
-		return
-	}
-
-	httpResp, err := d.client.Do(httpReq)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to send HTTP request",
-			fmt.Sprintf("Error: %s", err),
-		)
-		return
-	}
-	defer httpResp.Body.Close()
+	tflog.Debug(ctx, "Querying host suggestions", map[string]interface{}{"query": queryParams.Encode()})
 
-	// Check for a successful response
-	if httpResp.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP response status",
-			fmt.Sprintf("Received status code: %d", httpResp.StatusCode),
-		)
-		return
-	}
-
-	// Parse the JSON response
+	// List follows the next links of a paginated response, so with limit
+	// set every page from offset onwards is read.
 	var apiResponse []struct {
 		ID   string `json:"id"`
 		Name string `json:"name"`
-		// Add other fields as needed based on the API response
 	}
-
-	if err := json.NewDecoder(httpResp.Body).Decode(&apiResponse); err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to decode JSON response",
-			fmt.Sprintf("Error: %s", err),
-		)
+	if err := d.client.api.List(ctx, "/api/v1/assets/hosts/suggestions/?"+queryParams.Encode(), &apiResponse); err != nil {
+		addAPIError(&resp.Diagnostics, err, "list asset hosts", "asset view", nil)
 		return
 	}
 
-	// Map the API response to the Terraform data model
+	// Map the API response to the Terraform data model. Every page has been
+	// read, so there is no next or previous page to report.
 	data.TotalCount = types.Int64Value(int64(len(apiResponse)))
-	data.Next = types.StringNull()     // If the API does not provide pagination info, set to null
-	data.Previous = types.StringNull() // If the API does not provide pagination info, set to null
+	data.Next = types.StringNull()
+	data.Previous = types.StringNull()
 
 	data.Results = make([]HostModel, 0, len(apiResponse))
 	for _, result := range apiResponse {
 		data.Results = append(data.Results, HostModel{
-			ID:   types.StringValue(result.ID),
-			Name: types.StringValue(result.Name),
-			// Map other fields as needed
+			ID:       types.StringValue(result.ID),
+			Name:     types.StringValue(result.Name),
+			Address:  types.StringNull(),
+			Platform: types.StringNull(),
+			IsActive: types.BoolNull(),
+			Comment:  types.StringNull(),
 		})
 	}
 
+	// Suggestions only carry the ID and name; fetch the rest per host.
+	if data.Details.ValueBool() {
+		ids := make([]string, 0, len(data.Results))
+		for _, result := range data.Results {
+			ids = append(ids, result.ID.ValueString())
+		}
+		details, err := d.client.getHosts(ctx, ids)
+		if err != nil {
+			warnIfRateLimited(&resp.Diagnostics, err, "read asset hosts")
+			resp.Diagnostics.AddError(
+				"Failed to read host details",
+				fmt.Sprintf("Error: %s", err),
+			)
+			return
+		}
+		for i, detail := range details {
+			flattenHostDetails(&resp.Diagnostics, &data.Results[i], detail)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Set the data model as the response
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// flattenHostDetails maps a host detail response onto a result. Missing or
+// null fields are left null.
+func flattenHostDetails(diags *diag.Diagnostics, model *HostModel, result map[string]interface{}) {
+	if address, ok := decodeStringField(diags, result, "address"); ok {
+		model.Address = types.StringValue(address)
+	}
+	if platform, ok := decodePlatformField(diags, result); ok {
+		model.Platform = types.StringValue(platform)
+	}
+	if comment, ok := decodeStringField(diags, result, "comment"); ok {
+		model.Comment = types.StringValue(comment)
+	}
+	if isActive, ok := result["is_active"].(bool); ok {
+		model.IsActive = types.BoolValue(isActive)
+	}
+
+	nodes, _ := decodeListField(diags, result, "nodes")
+	model.Nodes = make([]types.String, 0, len(nodes))
+	for _, node := range nodes {
+		if id := refID(node); id != "" {
+			model.Nodes = append(model.Nodes, types.StringValue(id))
+		}
+	}
+	protocols, _ := decodeListField(diags, result, "protocols")
+	model.Protocols = flattenHostProtocols(nil, protocols)
+}
+
+// getHosts fetches the details of the hosts with the given IDs, sending at
+// most maxConcurrency requests at a time. Details are returned in the order
+// of ids. Every failed fetch is reported in the returned error; fetches not
+// yet started when ctx is done are skipped.
+func (c *jumpServerClient) getHosts(ctx context.Context, ids []string) ([]map[string]interface{}, error) {
+	workers := c.maxConcurrency
+	if workers < 1 {
+		workers = 1
+	}
+
+	details := make([]map[string]interface{}, len(ids))
+	errs := make([]error, len(ids))
+	slots := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+
+dispatch:
+	for i, id := range ids {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			break dispatch
+		}
+
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			detail, err := c.getHost(ctx, id)
+			if err != nil {
+				errs[i] = fmt.Errorf("host %s: %w", id, err)
+				return
+			}
+			details[i] = detail
+		}(i, id)
+	}
+	wg.Wait()
+
+	return details, errors.Join(errs...)
+}
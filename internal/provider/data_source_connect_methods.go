@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the data source implements the required interfaces.
+var _ datasource.DataSource = &ConnectMethodsDataSource{}
+
+// ConnectMethodsDataSource defines the data source implementation.
+type ConnectMethodsDataSource struct {
+	client *jumpServerClient
+}
+
+// ConnectMethodsDataSourceModel describes the data source data model.
+type ConnectMethodsDataSourceModel struct {
+	Protocol types.String         `tfsdk:"protocol"`
+	Results  []ConnectMethodModel `tfsdk:"results"`
+}
+
+// ConnectMethodModel describes a single connection method.
+type ConnectMethodModel struct {
+	Component types.String `tfsdk:"component"`
+	Protocol  types.String `tfsdk:"protocol"`
+	Value     types.String `tfsdk:"value"`
+}
+
+func NewConnectMethodsDataSource() datasource.DataSource {
+	return &ConnectMethodsDataSource{}
+}
+
+func (d *ConnectMethodsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_methods"
+}
+
+func (d *ConnectMethodsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the connection methods JumpServer components offer for each protocol.",
+		Attributes: map[string]schema.Attribute{
+			"protocol": schema.StringAttribute{
+				Description: "Only return connection methods for this protocol.",
+				Optional:    true,
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "The list of connection methods.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"component": schema.StringAttribute{
+							Description: "The component providing the method, e.g. koko or lion.",
+							Computed:    true,
+						},
+						"protocol": schema.StringAttribute{
+							Description: "The protocol the method connects with.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The method name, as referenced by connect-method ACLs.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConnectMethodsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConnectMethodsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConnectMethodsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Querying connect methods")
+
+	// The API groups the methods by protocol name
+	var apiResponse map[string][]struct {
+		Component string `json:"component"`
+		Value     string `json:"value"`
+	}
+	if err := d.client.api.Get(ctx, "/api/v1/terminal/components/connect-methods/", nil, &apiResponse); err != nil {
+		addAPIError(&resp.Diagnostics, err, "list connection methods", "terminal view", nil)
+		return
+	}
+
+	protocols := make([]string, 0, len(apiResponse))
+	for protocol := range apiResponse {
+		if data.Protocol.IsNull() || data.Protocol.ValueString() == protocol {
+			protocols = append(protocols, protocol)
+		}
+	}
+	sort.Strings(protocols)
+
+	// Map the API response to the Terraform data model
+	data.Results = []ConnectMethodModel{}
+	for _, protocol := range protocols {
+		for _, method := range apiResponse[protocol] {
+			data.Results = append(data.Results, ConnectMethodModel{
+				Component: types.StringValue(method.Component),
+				Protocol:  types.StringValue(protocol),
+				Value:     types.StringValue(method.Value),
+			})
+		}
+	}
+
+	// Set the data model as the response
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
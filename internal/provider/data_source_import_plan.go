@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the data source implements the required interfaces.
+var _ datasource.DataSource = &ImportPlanDataSource{}
+
+// ImportPlanDataSource defines the data source implementation.
+type ImportPlanDataSource struct {
+	client *jumpServerClient
+}
+
+// ImportPlanDataSourceModel describes the data source data model.
+type ImportPlanDataSourceModel struct {
+	Node     types.String       `tfsdk:"node"`
+	Platform types.String       `tfsdk:"platform"`
+	Search   types.String       `tfsdk:"search"`
+	Imports  []ImportEntryModel `tfsdk:"imports"`
+	Content  types.String       `tfsdk:"content"`
+}
+
+// ImportEntryModel describes a single asset to import.
+type ImportEntryModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Address types.String `tfsdk:"address"`
+}
+
+var invalidResourceNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+func NewImportPlanDataSource() datasource.DataSource {
+	return &ImportPlanDataSource{}
+}
+
+func (d *ImportPlanDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_import_plan"
+}
+
+func (d *ImportPlanDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates Terraform import blocks for existing JumpServer asset hosts matching the given filters.",
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Description: "Only include hosts under this node ID.",
+				Optional:    true,
+			},
+			"platform": schema.StringAttribute{
+				Description: "Only include hosts on this platform.",
+				Optional:    true,
+			},
+			"search": schema.StringAttribute{
+				Description: "A search term.",
+				Optional:    true,
+			},
+			"imports": schema.ListNestedAttribute{
+				Description: "The hosts to import and their suggested resource addresses.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the host.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the host.",
+							Computed:    true,
+						},
+						"address": schema.StringAttribute{
+							Description: "The suggested Terraform resource address.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "The generated import blocks. Write them to a file with the `local_file` resource or `terraform output -raw`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ImportPlanDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ImportPlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ImportPlanDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build query parameters
+	queryParams := url.Values{}
+	if !data.Node.IsNull() {
+		queryParams.Add("node", data.Node.ValueString())
+	}
+	if !data.Platform.IsNull() {
+		queryParams.Add("platform", data.Platform.ValueString())
+	}
+	if !data.Search.IsNull() {
+		queryParams.Add("search", data.Search.ValueString())
+	}
+
+	tflog.Debug(ctx, "Querying hosts for import", map[string]interface{}{"query": queryParams.Encode()})
+
+	// List follows the next links of a paginated response, so every
+	// matching host is returned.
+	var hosts []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	if err := d.client.api.List(ctx, "/api/v1/assets/hosts/?"+queryParams.Encode(), &hosts); err != nil {
+		addAPIError(&resp.Diagnostics, err, "list asset hosts", "asset view", nil)
+		return
+	}
+
+	// Map the hosts to import blocks with unique resource addresses
+	names := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		names = append(names, host.Name)
+	}
+	resourceNames := importResourceNames(names)
+
+	var content strings.Builder
+	data.Imports = make([]ImportEntryModel, 0, len(hosts))
+	for i, host := range hosts {
+		address := "jumpserver_asset_host." + resourceNames[i]
+
+		data.Imports = append(data.Imports, ImportEntryModel{
+			ID:      types.StringValue(host.ID),
+			Name:    types.StringValue(host.Name),
+			Address: types.StringValue(address),
+		})
+		fmt.Fprintf(&content, "import {\n  to = %s\n  id = %q\n}\n\n", address, host.ID)
+	}
+	data.Content = types.StringValue(content.String())
+
+	// Set the data model as the response
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// importResourceName turns an asset name into a valid Terraform resource name.
+func importResourceName(name string) string {
+	name = invalidResourceNameChars.ReplaceAllString(strings.ToLower(name), "_")
+	name = strings.Trim(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "host_" + name
+	}
+	return name
+}
+
+// importResourceNames returns a unique resource name for each asset name.
+// The first asset with a name keeps it; later ones get the lowest "_N"
+// suffix that is neither taken nor the plain name of another asset, so a
+// host named "web_2" never collides with the second host named "web".
+func importResourceNames(assetNames []string) []string {
+	taken := make(map[string]bool, len(assetNames))
+	for _, name := range assetNames {
+		taken[importResourceName(name)] = true
+	}
+
+	kept := make(map[string]bool, len(assetNames))
+	names := make([]string, 0, len(assetNames))
+	for _, assetName := range assetNames {
+		name := importResourceName(assetName)
+		if kept[name] {
+			base := name
+			for n := 2; taken[name]; n++ {
+				name = fmt.Sprintf("%s_%d", base, n)
+			}
+			taken[name] = true
+		}
+		kept[name] = true
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestImportResourceNames(t *testing.T) {
+	tests := []struct {
+		assets []string
+		want   []string
+	}{
+		{[]string{"web", "db"}, []string{"web", "db"}},
+		{[]string{"Web-01", "web 01", "web_01"}, []string{"web_01", "web_01_2", "web_01_3"}},
+		// Generated suffixes skip the names of other assets, wherever they appear.
+		{[]string{"web", "web", "web_2"}, []string{"web", "web_3", "web_2"}},
+		{[]string{"web_2", "web", "web"}, []string{"web_2", "web", "web_3"}},
+		{[]string{"web_2", "web_2"}, []string{"web_2", "web_2_2"}},
+		{[]string{"1st", "", "--"}, []string{"host_1st", "host_", "host__2"}},
+	}
+	for _, tt := range tests {
+		if got := importResourceNames(tt.assets); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("importResourceNames(%q) = %q, want %q", tt.assets, got, tt.want)
+		}
+	}
+}
+
+func TestAccImportPlanDataSource(t *testing.T) {
+	server := newTestServer(t)
+	first := server.put(testHostsPath, map[string]interface{}{"name": "web"})
+	second := server.put(testHostsPath, map[string]interface{}{"name": "web"})
+	third := server.put(testHostsPath, map[string]interface{}{"name": "web_2"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "jumpserver_import_plan" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jumpserver_import_plan.test", "imports.#", "3"),
+					resource.TestCheckResourceAttr("data.jumpserver_import_plan.test", "imports.0.address", "jumpserver_asset_host.web"),
+					resource.TestCheckResourceAttr("data.jumpserver_import_plan.test", "imports.1.address", "jumpserver_asset_host.web_3"),
+					resource.TestCheckResourceAttr("data.jumpserver_import_plan.test", "imports.2.address", "jumpserver_asset_host.web_2"),
+					resource.TestCheckResourceAttr("data.jumpserver_import_plan.test", "content", fmt.Sprintf(
+						"import {\n  to = jumpserver_asset_host.web\n  id = %q\n}\n\n"+
+							"import {\n  to = jumpserver_asset_host.web_3\n  id = %q\n}\n\n"+
+							"import {\n  to = jumpserver_asset_host.web_2\n  id = %q\n}\n\n",
+						first, second, third,
+					)),
+				),
+			},
+		},
+	})
+}
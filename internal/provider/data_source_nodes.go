@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+// Ensure the data source implements the required interfaces.
+var _ datasource.DataSource = &NodesDataSource{}
+
+// NodesDataSource defines the data source implementation.
+type NodesDataSource struct {
+	client *jumpServerClient
+}
+
+// NodesDataSourceModel describes the data source data model.
+type NodesDataSourceModel struct {
+	Search  types.String `tfsdk:"search"`
+	Value   types.String `tfsdk:"value"`
+	Results []NodeModel  `tfsdk:"results"`
+}
+
+// NodeModel describes a single node.
+type NodeModel struct {
+	ID           types.String `tfsdk:"id"`
+	Key          types.String `tfsdk:"key"`
+	Value        types.String `tfsdk:"value"`
+	FullValue    types.String `tfsdk:"full_value"`
+	AssetsAmount types.Int64  `tfsdk:"assets_amount"`
+}
+
+func NewNodesDataSource() datasource.DataSource {
+	return &NodesDataSource{}
+}
+
+func (d *NodesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nodes"
+}
+
+func (d *NodesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the asset tree nodes, e.g. to look up node IDs for an asset permission.",
+		Attributes: map[string]schema.Attribute{
+			"search": schema.StringAttribute{
+				Description: "Only return nodes matching this search term.",
+				Optional:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "Only return nodes with this name.",
+				Optional:    true,
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "The list of nodes.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the node.",
+							Computed:    true,
+						},
+						"key": schema.StringAttribute{
+							Description: "The tree key of the node, e.g. `1:2`.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The name of the node.",
+							Computed:    true,
+						},
+						"full_value": schema.StringAttribute{
+							Description: "The full path of the node, e.g. `/Default/prod`.",
+							Computed:    true,
+						},
+						"assets_amount": schema.Int64Attribute{
+							Description: "The number of assets under the node. Null when the API does not report it.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NodesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NodesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build query parameters
+	queryParams := url.Values{}
+	if !data.Search.IsNull() {
+		queryParams.Add("search", data.Search.ValueString())
+	}
+	if !data.Value.IsNull() {
+		queryParams.Add("value", data.Value.ValueString())
+	}
+
+	nodes, err := d.client.listNodes(ctx, queryParams)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read nodes", "asset view", nil)
+		return
+	}
+
+	// Map the API response to the Terraform data model
+	data.Results = make([]NodeModel, 0, len(nodes))
+	for _, node := range nodes {
+		assetsAmount := types.Int64Null()
+		if node.AssetsAmount != nil {
+			assetsAmount = types.Int64Value(*node.AssetsAmount)
+		}
+		data.Results = append(data.Results, NodeModel{
+			ID:           types.StringValue(node.ID),
+			Key:          types.StringValue(node.Key),
+			Value:        types.StringValue(node.Value),
+			FullValue:    types.StringValue(node.FullValue),
+			AssetsAmount: assetsAmount,
+		})
+	}
+
+	// Set the data model as the response
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listNodes queries the nodes list endpoint with the given filters. List
+// follows the next links of a paginated response, so every match is returned.
+func (c *jumpServerClient) listNodes(ctx context.Context, queryParams url.Values, opts ...client.RequestOption) ([]apiNode, error) {
+	var nodes []apiNode
+	if err := c.api.List(ctx, "/api/v1/assets/nodes/?"+queryParams.Encode(), &nodes, opts...); err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
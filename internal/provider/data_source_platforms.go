@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the data source implements the required interfaces.
+var _ datasource.DataSource = &PlatformsDataSource{}
+
+// PlatformsDataSource defines the data source implementation.
+type PlatformsDataSource struct {
+	client *jumpServerClient
+}
+
+// PlatformsDataSourceModel describes the data source data model.
+type PlatformsDataSourceModel struct {
+	Name     types.String    `tfsdk:"name"`
+	Category types.String    `tfsdk:"category"`
+	Type     types.String    `tfsdk:"type"`
+	Results  []PlatformModel `tfsdk:"results"`
+}
+
+// PlatformModel describes a single platform.
+type PlatformModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Category types.String `tfsdk:"category"`
+	Type     types.String `tfsdk:"type"`
+}
+
+// apiPlatform is a platform as returned by the platforms list endpoint.
+type apiPlatform struct {
+	ID       json.Number `json:"id"`
+	Name     string      `json:"name"`
+	Category choiceField `json:"category"`
+	Type     choiceField `json:"type"`
+}
+
+func NewPlatformsDataSource() datasource.DataSource {
+	return &PlatformsDataSource{}
+}
+
+func (d *PlatformsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platforms"
+}
+
+func (d *PlatformsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the asset platforms, e.g. to look up the platform ID for an asset resource.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return the platform with this name.",
+				Optional:    true,
+			},
+			"category": schema.StringAttribute{
+				Description: "Only return platforms in this category, e.g. host or database.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return platforms of this type, e.g. linux or mysql.",
+				Optional:    true,
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "The list of platforms.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the platform.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the platform.",
+							Computed:    true,
+						},
+						"category": schema.StringAttribute{
+							Description: "The category of the platform.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the platform.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PlatformsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PlatformsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PlatformsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build query parameters
+	queryParams := url.Values{}
+	if !data.Name.IsNull() {
+		queryParams.Add("name", data.Name.ValueString())
+	}
+	if !data.Category.IsNull() {
+		queryParams.Add("category", data.Category.ValueString())
+	}
+	if !data.Type.IsNull() {
+		queryParams.Add("type", data.Type.ValueString())
+	}
+
+	platforms, err := d.client.listPlatforms(ctx, queryParams)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read platforms", "asset view", nil)
+		return
+	}
+
+	// Map the API response to the Terraform data model
+	data.Results = make([]PlatformModel, 0, len(platforms))
+	for _, platform := range platforms {
+		data.Results = append(data.Results, PlatformModel{
+			ID:       types.StringValue(platform.ID.String()),
+			Name:     types.StringValue(platform.Name),
+			Category: types.StringValue(platform.Category.Value),
+			Type:     types.StringValue(platform.Type.Value),
+		})
+	}
+
+	// Set the data model as the response
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listPlatforms queries the platforms list endpoint with the given filters.
+// List follows the next links of a paginated response, so every match is
+// returned.
+func (c *jumpServerClient) listPlatforms(ctx context.Context, queryParams url.Values) ([]apiPlatform, error) {
+	tflog.Debug(ctx, "Listing platforms", map[string]interface{}{"query": queryParams.Encode()})
+	var platforms []apiPlatform
+	if err := c.api.List(ctx, "/api/v1/assets/platforms/?"+queryParams.Encode(), &platforms); err != nil {
+		return nil, err
+	}
+	return platforms, nil
+}
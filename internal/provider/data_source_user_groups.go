@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the data source implements the required interfaces.
+var _ datasource.DataSource = &UserGroupsDataSource{}
+
+// UserGroupsDataSource defines the data source implementation.
+type UserGroupsDataSource struct {
+	client *jumpServerClient
+}
+
+// UserGroupsDataSourceModel describes the data source data model.
+type UserGroupsDataSourceModel struct {
+	Name    types.String     `tfsdk:"name"`
+	Search  types.String     `tfsdk:"search"`
+	Results []UserGroupModel `tfsdk:"results"`
+}
+
+// UserGroupModel describes a single user group.
+type UserGroupModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Comment     types.String `tfsdk:"comment"`
+	UsersAmount types.Int64  `tfsdk:"users_amount"`
+}
+
+// apiUserGroupSummary is a user group as returned by the groups list endpoint.
+type apiUserGroupSummary struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Comment     string `json:"comment"`
+	UsersAmount int64  `json:"users_amount"`
+}
+
+func NewUserGroupsDataSource() datasource.DataSource {
+	return &UserGroupsDataSource{}
+}
+
+func (d *UserGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_groups"
+}
+
+func (d *UserGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists JumpServer user groups, e.g. to look up user group IDs for an asset permission.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return user groups with this name.",
+				Optional:    true,
+			},
+			"search": schema.StringAttribute{
+				Description: "Only return user groups matching this search term.",
+				Optional:    true,
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "The list of user groups.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the user group.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the user group.",
+							Computed:    true,
+						},
+						"comment": schema.StringAttribute{
+							Description: "The comment on the user group.",
+							Computed:    true,
+						},
+						"users_amount": schema.Int64Attribute{
+							Description: "The number of users in the user group.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UserGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserGroupsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build query parameters
+	queryParams := url.Values{}
+	if !data.Name.IsNull() {
+		queryParams.Add("name", data.Name.ValueString())
+	}
+	if !data.Search.IsNull() {
+		queryParams.Add("search", data.Search.ValueString())
+	}
+
+	// List follows the next links of a paginated response, so every
+	// matching user group is returned.
+	var groups []apiUserGroupSummary
+	if err := d.client.api.List(ctx, "/api/v1/users/groups/?"+queryParams.Encode(), &groups); err != nil {
+		addAPIError(&resp.Diagnostics, err, "read user groups", "user view", nil)
+		return
+	}
+
+	// Map the API response to the Terraform data model
+	data.Results = make([]UserGroupModel, 0, len(groups))
+	for _, group := range groups {
+		data.Results = append(data.Results, UserGroupModel{
+			ID:          types.StringValue(group.ID),
+			Name:        types.StringValue(group.Name),
+			Comment:     types.StringValue(group.Comment),
+			UsersAmount: types.Int64Value(group.UsersAmount),
+		})
+	}
+
+	// Set the data model as the response
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
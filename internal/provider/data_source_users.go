@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the data source implements the required interfaces.
+var _ datasource.DataSource = &UsersDataSource{}
+
+// UsersDataSource defines the data source implementation.
+type UsersDataSource struct {
+	client *jumpServerClient
+}
+
+// UsersDataSourceModel describes the data source data model.
+type UsersDataSourceModel struct {
+	Username types.String `tfsdk:"username"`
+	Email    types.String `tfsdk:"email"`
+	Name     types.String `tfsdk:"name"`
+	Source   types.String `tfsdk:"source"`
+	Search   types.String `tfsdk:"search"`
+	Results  []UserModel  `tfsdk:"results"`
+}
+
+// UserModel describes a single user.
+type UserModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Username types.String `tfsdk:"username"`
+	Email    types.String `tfsdk:"email"`
+	IsActive types.Bool   `tfsdk:"is_active"`
+}
+
+// apiUserSummary is a user as returned by the users list endpoint.
+type apiUserSummary struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+	IsActive bool   `json:"is_active"`
+}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists JumpServer users, e.g. to look up user IDs for an asset permission.",
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Description: "Only return users with this username.",
+				Optional:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "Only return users with this email address.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Only return users with this display name.",
+				Optional:    true,
+			},
+			"source": schema.StringAttribute{
+				Description: "Only return users from this source, e.g. `local`, `ldap` or `openid`.",
+				Optional:    true,
+			},
+			"search": schema.StringAttribute{
+				Description: "Only return users matching this search term.",
+				Optional:    true,
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "The list of users.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the user.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The display name of the user.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "The username of the user.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address of the user.",
+							Computed:    true,
+						},
+						"is_active": schema.BoolAttribute{
+							Description: "Whether the user is active.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build query parameters
+	queryParams := url.Values{}
+	filters := map[string]types.String{
+		"username": data.Username,
+		"email":    data.Email,
+		"name":     data.Name,
+		"source":   data.Source,
+		"search":   data.Search,
+	}
+	for key, value := range filters {
+		if !value.IsNull() {
+			queryParams.Add(key, value.ValueString())
+		}
+	}
+
+	// List follows the next links of a paginated response, so every
+	// matching user is returned.
+	var users []apiUserSummary
+	if err := d.client.api.List(ctx, "/api/v1/users/users/?"+queryParams.Encode(), &users); err != nil {
+		addAPIError(&resp.Diagnostics, err, "read users", "user view", nil)
+		return
+	}
+
+	// Map the API response to the Terraform data model
+	data.Results = make([]UserModel, 0, len(users))
+	for _, user := range users {
+		data.Results = append(data.Results, UserModel{
+			ID:       types.StringValue(user.ID),
+			Name:     types.StringValue(user.Name),
+			Username: types.StringValue(user.Username),
+			Email:    types.StringValue(user.Email),
+			IsActive: types.BoolValue(user.IsActive),
+		})
+	}
+
+	// Set the data model as the response
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
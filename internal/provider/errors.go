@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+// addForbiddenError reports a 403 response with a hint about the RBAC role the
+// provider's user or token is missing. It returns false for any other status.
+func addForbiddenError(diags *diag.Diagnostics, httpResp *http.Response, body []byte, action, role string) bool {
+	if httpResp.StatusCode != http.StatusForbidden {
+		return false
+	}
+
+	org := "the default organization"
+	if httpResp.Request != nil {
+		if orgID := httpResp.Request.Header.Get(client.OrgHeader); orgID != "" {
+			org = fmt.Sprintf("organization %q", orgID)
+		}
+	}
+
+	diags.AddError(
+		"Insufficient JumpServer Permissions",
+		fmt.Sprintf("JumpServer denied permission to %s in %s. "+
+			"Ensure the user or token configured for the provider holds a role granting %s permissions "+
+			"(for example the organization admin role) in that organization.\n\nResponse: %s",
+			action, org, role, string(body)),
+	)
+	return true
+}
+
+// addRateLimitWarning warns about a 429 response, naming when the limit resets
+// as reported by the Retry-After or X-RateLimit-* headers. It returns false
+// for any other status.
+func addRateLimitWarning(diags *diag.Diagnostics, httpResp *http.Response, action string) bool {
+	if httpResp.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+
+	detail := fmt.Sprintf("JumpServer is throttling requests and refused to %s (HTTP 429).", action)
+	limit := httpResp.Header.Get("X-RateLimit-Limit")
+	remaining := httpResp.Header.Get("X-RateLimit-Remaining")
+	if limit != "" && remaining != "" {
+		detail += fmt.Sprintf(" %s of %s requests remain in the current window.", remaining, limit)
+	}
+	if reset, ok := rateLimitReset(httpResp.Header, time.Now()); ok {
+		detail += fmt.Sprintf(" The limit resets at %s (in %s).", reset.Format(time.RFC3339), time.Until(reset).Round(time.Second))
+	}
+	detail += " Lower the number of parallel requests, e.g. with `terraform apply -parallelism=2` or the provider's " +
+		"`max_concurrency`, then run Terraform again once the limit has reset."
+
+	diags.AddWarning("JumpServer Rate Limit Reached", detail)
+	return true
+}
+
+// warnIfRateLimited adds the rate limit warning when err is, or wraps, an API
+// error with status 429. Callers that report an API error themselves instead
+// of through addAPIError use it so throttling is still explained.
+func warnIfRateLimited(diags *diag.Diagnostics, err error, action string) bool {
+	var apiErr *client.Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return addRateLimitWarning(diags, apiErr.Response, action)
+}
+
+// rateLimitReset returns when a rate limit resets, from the Retry-After header
+// (seconds or an HTTP date) or else X-RateLimit-Reset (a Unix time, or seconds
+// from now for small values).
+func rateLimitReset(header http.Header, now time.Time) (time.Time, bool) {
+	if retryAfter := header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			return now.Add(time.Duration(seconds) * time.Second), true
+		}
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			return date, true
+		}
+	}
+	if reset := header.Get("X-RateLimit-Reset"); reset != "" {
+		if value, err := strconv.ParseInt(reset, 10, 64); err == nil && value >= 0 {
+			// Values before 2001 cannot be Unix times, so they are a delay.
+			if value < 1_000_000_000 {
+				return now.Add(time.Duration(value) * time.Second), true
+			}
+			return time.Unix(value, 0), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// nonFieldErrorKeys hold validation errors that do not belong to a field.
+var nonFieldErrorKeys = map[string]bool{
+	"detail":           true,
+	"non_field_errors": true,
+}
+
+// addValidationErrors reports a 400 response whose body maps API field names
+// to error messages, e.g. {"address": ["This field is required."]}, as one
+// diagnostic per field. Fields listed in fieldPaths are reported on that
+// attribute; any other field is reported as a plain error naming the field.
+// It returns false when the body is not in that shape, so the caller can fall
+// back to reporting the raw response.
+func addValidationErrors(diags *diag.Diagnostics, httpResp *http.Response, body []byte, fieldPaths map[string]path.Path) bool {
+	if httpResp.StatusCode != http.StatusBadRequest {
+		return false
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return false
+	}
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	reported := false
+	for _, key := range keys {
+		messages := validationMessages(fields[key])
+		if len(messages) == 0 {
+			continue
+		}
+		detail := strings.Join(messages, "\n")
+
+		switch attrPath, ok := fieldPaths[key]; {
+		case ok:
+			diags.AddAttributeError(attrPath, "Invalid Attribute Value", "JumpServer rejected the value: "+detail)
+		case nonFieldErrorKeys[key]:
+			diags.AddError("API Validation Error", detail)
+		default:
+			diags.AddError("API Validation Error", fmt.Sprintf("JumpServer rejected field %q: %s", key, detail))
+		}
+		reported = true
+	}
+	return reported
+}
+
+// validationMessages flattens the error messages of one field. Errors on list
+// elements and nested objects are prefixed with the element index and the
+// nested field name, e.g. "[0] port: A valid integer is required.".
+func validationMessages(value interface{}) []string {
+	var messages []string
+	switch v := value.(type) {
+	case string:
+		messages = append(messages, v)
+	case []interface{}:
+		for i, item := range v {
+			if _, ok := item.(map[string]interface{}); ok {
+				for _, msg := range validationMessages(item) {
+					messages = append(messages, fmt.Sprintf("[%d] %s", i, msg))
+				}
+				continue
+			}
+			messages = append(messages, validationMessages(item)...)
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			for _, msg := range validationMessages(v[key]) {
+				messages = append(messages, key+": "+msg)
+			}
+		}
+	}
+	return messages
+}
+
+// rootFieldPaths maps API fields to the top-level attributes of the same name.
+func rootFieldPaths(names ...string) map[string]path.Path {
+	paths := make(map[string]path.Path, len(names))
+	for _, name := range names {
+		paths[name] = path.Root(name)
+	}
+	return paths
+}
+
+// addAPIError reports an error returned by the API client while trying to
+// perform action, e.g. "create asset hosts". 403 responses name the missing
+// role, 400 responses are reported per field when fieldPaths is not nil, and
+// any other failure is reported with the response body. 429 responses also
+// get a rate limit warning.
+func addAPIError(diags *diag.Diagnostics, err error, action, role string, fieldPaths map[string]path.Path) {
+	var apiErr *client.Error
+	if !errors.As(err, &apiErr) {
+		diags.AddError("HTTP Request Error", fmt.Sprintf("Unable to %s: %s", action, err))
+		return
+	}
+	addRateLimitWarning(diags, apiErr.Response, action)
+	if addForbiddenError(diags, apiErr.Response, apiErr.Body, action, role) {
+		return
+	}
+	if fieldPaths != nil && addValidationErrors(diags, apiErr.Response, apiErr.Body, fieldPaths) {
+		return
+	}
+	diags.AddError("HTTP Status Error", fmt.Sprintf("Unable to %s: %s, Response: %s", action, apiErr.Response.Status, string(apiErr.Body)))
+}
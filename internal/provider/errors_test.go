@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+func TestAddForbiddenErrorNamesOrganization(t *testing.T) {
+	for orgID, want := range map[string]string{
+		"":         "in the default organization",
+		"00000002": `in organization "00000002"`,
+	} {
+		req, _ := http.NewRequest(http.MethodGet, "http://jumpserver.invalid/api/v1/assets/hosts/", nil)
+		client.WithOrg(orgID)(req)
+		httpResp := &http.Response{StatusCode: http.StatusForbidden, Request: req}
+
+		var diags diag.Diagnostics
+		if !addForbiddenError(&diags, httpResp, []byte(`{"detail":"denied"}`), "read asset hosts", "asset view") {
+			t.Fatalf("org %q: addForbiddenError() = false for a 403", orgID)
+		}
+		if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, want) {
+			t.Errorf("org %q: detail %q does not mention %s", orgID, detail, want)
+		}
+	}
+}
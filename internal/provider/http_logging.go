@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// debugHTTPEnvVar enables request/response logging in the shared client.
+const debugHTTPEnvVar = "JUMP_SERVER_DEBUG_HTTP"
+
+const redacted = "REDACTED"
+
+// sensitiveHeaders are never logged verbatim.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "X-Csrftoken"}
+
+// sensitiveFieldMarkers mark JSON body fields whose values are masked.
+var sensitiveFieldMarkers = []string{"password", "secret", "token", "private_key", "passphrase"}
+
+// logRoundTrip sends the request through next and logs both sides of the
+// exchange at debug level with credentials masked.
+func logRoundTrip(next http.RoundTripper, req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	var reqBody []byte
+	if req.Body != nil {
+		reqBody, _ = io.ReadAll(req.Body)
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	tflog.Debug(ctx, "JumpServer API request", map[string]interface{}{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"headers": redactHeaders(req.Header),
+		"body":    maskBody(reqBody),
+	})
+
+	start := time.Now()
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		tflog.Debug(ctx, "JumpServer API request failed", map[string]interface{}{
+			"method":   req.Method,
+			"url":      req.URL.String(),
+			"error":    err.Error(),
+			"duration": time.Since(start).String(),
+		})
+		return nil, err
+	}
+
+	respBody, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	logResponse(ctx, req, resp, respBody, time.Since(start))
+	return resp, nil
+}
+
+func logResponse(ctx context.Context, req *http.Request, resp *http.Response, body []byte, duration time.Duration) {
+	tflog.Debug(ctx, "JumpServer API response", map[string]interface{}{
+		"method":   req.Method,
+		"url":      req.URL.String(),
+		"status":   resp.Status,
+		"headers":  redactHeaders(resp.Header),
+		"body":     maskBody(body),
+		"duration": duration.String(),
+	})
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	out := make(map[string]string, len(header))
+	for name, values := range header {
+		out[name] = strings.Join(values, ", ")
+	}
+	for _, name := range sensitiveHeaders {
+		if _, ok := out[http.CanonicalHeaderKey(name)]; ok {
+			out[http.CanonicalHeaderKey(name)] = redacted
+		}
+	}
+	return out
+}
+
+// maskBody masks secret-bearing fields in a JSON body. Non-JSON bodies are
+// returned as-is.
+func maskBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return string(body)
+	}
+	masked, err := json.Marshal(maskValue(decoded))
+	if err != nil {
+		return string(body)
+	}
+	return string(masked)
+}
+
+func maskValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if isSensitiveField(key) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = maskValue(field)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = maskValue(item)
+		}
+	}
+	return value
+}
+
+func isSensitiveField(key string) bool {
+	key = strings.ToLower(key)
+	for _, marker := range sensitiveFieldMarkers {
+		if strings.Contains(key, marker) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// 解析导入 ID：资源 UUID，或 "<组织 ID>:<资源 UUID>"（也接受 "/" 分隔）。未指定组织时 orgID 为空
+func parseOrgImportID(importID string) (orgID, id string, err error) {
+	sep := strings.IndexAny(importID, ":/")
+	switch {
+	case sep < 0 && importID != "":
+		return "", importID, nil
+	case sep <= 0 || sep == len(importID)-1 || strings.ContainsAny(importID[sep+1:], ":/"):
+		return "", "", fmt.Errorf("expected an import ID of the form <id> or <org_id>:<id>, got %q", importID)
+	}
+
+	orgID, id = importID[:sep], importID[sep+1:]
+	if _, err := uuid.Parse(orgID); err != nil {
+		return "", "", fmt.Errorf("the organization in import ID %q must be an organization UUID, got %q", importID, orgID)
+	}
+	return orgID, id, nil
+}
+
+// 导入可按组织管理的资源：导入 ID 带组织时同时设置 org_id，Read 在该组织中读取
+func importStateWithOrg(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgID, id, err := parseOrgImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if orgID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), orgID)...)
+	}
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseOrgImportID(t *testing.T) {
+	const (
+		org  = "00000000-0000-0000-0000-000000000002"
+		host = "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+	)
+	tests := []struct {
+		importID  string
+		wantOrgID string
+		wantID    string
+		wantErr   string
+	}{
+		{importID: host, wantID: host},
+		{importID: org + ":" + host, wantOrgID: org, wantID: host},
+		{importID: org + "/" + host, wantOrgID: org, wantID: host},
+		{importID: "", wantErr: "of the form"},
+		{importID: ":" + host, wantErr: "of the form"},
+		{importID: org + ":", wantErr: "of the form"},
+		{importID: org + ":" + host + ":extra", wantErr: "of the form"},
+		{importID: "Default:" + host, wantErr: `must be an organization UUID, got "Default"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.importID, func(t *testing.T) {
+			orgID, id, err := parseOrgImportID(tt.importID)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseOrgImportID(%q) error = %v, want one containing %q", tt.importID, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseOrgImportID(%q) error = %v", tt.importID, err)
+			}
+			if orgID != tt.wantOrgID || id != tt.wantID {
+				t.Errorf("parseOrgImportID(%q) = %q, %q, want %q, %q", tt.importID, orgID, id, tt.wantOrgID, tt.wantID)
+			}
+		})
+	}
+}
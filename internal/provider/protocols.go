@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/compat"
+)
+
+// protocolPortValidators reject ports outside the TCP port range.
+var protocolPortValidators = []validator.Int64{
+	int64validator.Between(1, 65535),
+}
+
+// protocolDefaultPortModifier plans the conventional port of a protocol
+// block when port is not configured, so the plan shows the port that is sent
+// to JumpServer. Protocols without a known default keep a null port and the
+// server applies its own.
+type protocolDefaultPortModifier struct{}
+
+var _ planmodifier.Int64 = protocolDefaultPortModifier{}
+
+func (m protocolDefaultPortModifier) Description(_ context.Context) string {
+	return "defaults the port to the conventional port of the protocol when not configured"
+}
+
+func (m protocolDefaultPortModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m protocolDefaultPortModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, req.Path.ParentPath().AtName("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.PlanValue = types.Int64Null()
+	if name.IsNull() || name.IsUnknown() {
+		return
+	}
+	if port, ok := compat.DefaultPort(name.ValueString()); ok {
+		resp.PlanValue = types.Int64Value(port)
+	}
+}
+
+// addUnknownProtocolErrors reports protocol blocks whose name is missing from
+// the compat default-port table, catching typos such as "sssh" at plan time.
+// It runs only when validate_protocols_from_api is off: with it on, names are
+// checked against the platform instead, so custom platforms using protocols
+// the table does not know are accepted.
+func addUnknownProtocolErrors(diags *diag.Diagnostics, protocols types.List) {
+	if protocols.IsUnknown() {
+		return
+	}
+	for i, proto := range protocols.Elements() {
+		protoObj, ok := proto.(types.Object)
+		if !ok {
+			continue
+		}
+		name, ok := protoObj.Attributes()["name"].(types.String)
+		if !ok || name.IsUnknown() || name.IsNull() {
+			continue
+		}
+		addUnknownProtocolError(diags, path.Root("protocols").AtListIndex(i).AtName("name"), name.ValueString())
+	}
+}
+
+// addUnknownProtocolError reports name at attrPath when it is not a known
+// protocol. See addUnknownProtocolErrors.
+func addUnknownProtocolError(diags *diag.Diagnostics, attrPath path.Path, name string) {
+	if _, ok := compat.DefaultPort(name); ok {
+		return
+	}
+	diags.AddAttributeError(
+		attrPath,
+		"Unknown Protocol",
+		fmt.Sprintf("Protocol %q is not one of the protocols JumpServer is known to support (%s). "+
+			"Check the name for typos. If the asset's platform defines its own protocols, set validate_protocols_from_api "+
+			"on the provider to check names against the platform instead.", name, strings.Join(compat.ProtocolNames(), ", ")),
+	)
+}
+
+// addCategoryProtocolError reports name at attrPath when the compat table
+// does not allow it for platforms of the given category.
+func addCategoryProtocolError(diags *diag.Diagnostics, attrPath path.Path, category, name string) {
+	if compat.PlatformSupportsProtocol(category, name) {
+		return
+	}
+	diags.AddAttributeError(
+		attrPath,
+		"Unsupported Protocol",
+		fmt.Sprintf("Protocol %q is not supported by %s platforms.", name, category),
+	)
+}
+
+// expandProtocolModels converts protocol blocks to the API representation.
+// Names are lowercased since JumpServer only accepts lowercase protocol
+// names, and unset ports are omitted so the server applies its default.
+func expandProtocolModels(protocols []ProtocolModel) []map[string]interface{} {
+	apiProtocols := make([]map[string]interface{}, 0, len(protocols))
+	for _, proto := range protocols {
+		protocol := map[string]interface{}{
+			"name": strings.ToLower(proto.Name.ValueString()),
+		}
+		if !proto.Port.IsNull() {
+			protocol["port"] = proto.Port.ValueInt64()
+		}
+		apiProtocols = append(apiProtocols, protocol)
+	}
+	return apiProtocols
+}
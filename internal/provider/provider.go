@@ -4,19 +4,29 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-jumpserver/internal/client"
 )
 
 // Ensure JumpServerProvider satisfies various provider interfaces.
@@ -36,6 +46,18 @@ type JumpServerProviderModel struct {
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
 	Token    types.String `tfsdk:"token"`
+
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	AccessKeySecret types.String `tfsdk:"access_key_secret"`
+	OrgID           types.String `tfsdk:"org_id"`
+
+	CACertFile               types.String `tfsdk:"ca_cert_file"`
+	CACertPEM                types.String `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify       types.Bool   `tfsdk:"insecure_skip_verify"`
+	ValidateProtocolsFromAPI types.Bool   `tfsdk:"validate_protocols_from_api"`
+	DefaultPlatform          types.String `tfsdk:"default_platform"`
+	RequestTimeout           types.String `tfsdk:"request_timeout"`
+	MaxConcurrency           types.Int64  `tfsdk:"max_concurrency"`
 }
 
 func (p *JumpServerProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -47,19 +69,77 @@ func (p *JumpServerProvider) Schema(ctx context.Context, req provider.SchemaRequ
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"base_url": schema.StringAttribute{
-				MarkdownDescription: "The base URL of the JumpServer API",
-				Required:            true,
+				MarkdownDescription: "The base URL of the JumpServer API, an http or https URL such as `https://jumpserver.example.com`. " +
+					"Trailing slashes are ignored. May also be set with the `JUMP_SERVER_BASE_URL` environment variable",
+				Optional: true,
 			},
 			"username": schema.StringAttribute{
-				MarkdownDescription: "The username for authentication",
-				Required:            true,
+				MarkdownDescription: "The username for authentication. May also be set with the `JUMP_SERVER_USERNAME` environment variable",
+				Optional:            true,
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password for authentication",
-				Required:            true,
+				MarkdownDescription: "The password for authentication. May also be set with the `JUMP_SERVER_PASSWORD` environment variable",
+				Optional:            true,
 				Sensitive:           true,
 			},
 			"token": schema.StringAttribute{
+				MarkdownDescription: "A JumpServer API token used as the bearer token instead of exchanging username/password. " +
+					"The token is not renewed when it expires. May also be set with the `JUMP_SERVER_TOKEN` environment variable",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"access_key_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of a JumpServer API access key. When set together with `access_key_secret`, " +
+					"every request is signed with the key instead of using username/password. " +
+					"May also be set with the `JUMP_SERVER_ACCESS_KEY_ID` environment variable",
+				Optional: true,
+			},
+			"access_key_secret": schema.StringAttribute{
+				MarkdownDescription: "The secret of the JumpServer API access key. " +
+					"May also be set with the `JUMP_SERVER_ACCESS_KEY_SECRET` environment variable",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the JumpServer organization all requests are scoped to. " +
+					"Defaults to the user's default organization. May also be set with the `JUMP_SERVER_ORG_ID` environment variable",
+				Optional:   true,
+				Validators: uuidValidators,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM bundle of CA certificates trusted in addition to the system roots when connecting to JumpServer",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA certificates trusted in addition to the system roots, as an alternative to `ca_cert_file`",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the JumpServer TLS certificate. Only use this for testing. " +
+					"May also be set with the `JUMP_SERVER_INSECURE` environment variable",
+				Optional: true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "The maximum duration of a single JumpServer API request, as a Go duration string such as `30s` or `2m`. Defaults to `30s`",
+				Optional:            true,
+			},
+			"max_concurrency": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of JumpServer API requests a data source sends in parallel when it reads " +
+					"many objects, e.g. host details. Defaults to `8`",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"default_platform": schema.StringAttribute{
+				MarkdownDescription: "The platform used by asset resources that do not set `platform`",
+				Optional:            true,
+			},
+			"validate_protocols_from_api": schema.BoolAttribute{
+				MarkdownDescription: "Validate asset protocol names against the protocols allowed by the asset's platform, " +
+					"fetched from the JumpServer API during planning. Falls back to the built-in protocol list when the lookup fails. " +
+					"When not enabled, protocol names are checked against the built-in list of known protocols; enable it for " +
+					"custom platforms whose protocols are not in that list.",
 				Optional: true,
 			},
 		},
@@ -75,117 +155,464 @@ func (p *JumpServerProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
-	baseURL := os.Getenv("JUMP_SERVER_BASE_URL")
-	username := os.Getenv("JUMP_SERVER_USERNAME")
-	password := os.Getenv("JUMP_SERVER_PASSWORD")
-
-	if !data.BaseURL.IsNull() {
-		baseURL = data.BaseURL.ValueString()
-	}
-	if !data.Username.IsNull() {
-		username = data.Username.ValueString()
-	}
-	if !data.Password.IsNull() {
-		password = data.Password.ValueString()
-	}
+	// Configuration values take precedence over environment variables.
+	baseURL := configOrEnv(ctx, data.BaseURL, "base_url", "JUMP_SERVER_BASE_URL")
+	username := configOrEnv(ctx, data.Username, "username", "JUMP_SERVER_USERNAME")
+	password := configOrEnv(ctx, data.Password, "password", "JUMP_SERVER_PASSWORD")
+	token := configOrEnv(ctx, data.Token, "token", "JUMP_SERVER_TOKEN")
+	accessKeyID := configOrEnv(ctx, data.AccessKeyID, "access_key_id", "JUMP_SERVER_ACCESS_KEY_ID")
+	accessKeySecret := configOrEnv(ctx, data.AccessKeySecret, "access_key_secret", "JUMP_SERVER_ACCESS_KEY_SECRET")
+	orgID := configOrEnv(ctx, data.OrgID, "org_id", "JUMP_SERVER_ORG_ID")
+	useAccessKey := accessKeyID != "" || accessKeySecret != ""
+	useToken := token != ""
+	usePassword := username != "" || password != ""
 
 	if baseURL == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("base_url"),
 			"Missing JumpServer API Base URL",
 			"The provider cannot create the JumpServer API client as there is a missing or empty value for the JumpServer API base URL. "+
-				"Set the base_url value in the configuration or use the JUMP_SERVER_BASE_URL environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"The base_url value in the configuration takes precedence; if it is not set, the JUMP_SERVER_BASE_URL environment variable is used. "+
+				"Set one of them to a non-empty value.",
 		)
-	}
-	if username == "" {
+	} else if normalized, err := normalizeBaseURL(baseURL); err != nil {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("username"),
-			"Missing JumpServer API Username",
-			"The provider cannot create the JumpServer API client as there is a missing or empty value for the JumpServer API username. "+
-				"Set the username value in the configuration or use the JUMP_SERVER_USERNAME environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+			path.Root("base_url"),
+			"Invalid JumpServer API Base URL",
+			fmt.Sprintf("The JumpServer API base URL must be an absolute http or https URL such as https://jumpserver.example.com, "+
+				"got %q: %s", baseURL, err),
 		)
+	} else {
+		baseURL = normalized
 	}
-	if password == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("password"),
-			"Missing JumpServer API Password",
-			"The provider cannot create the JumpServer API client as there is a missing or empty value for the JumpServer API password. "+
-				"Set the password value in the configuration or use the JUMP_SERVER_PASSWORD environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+	// Exactly one authentication method may be configured.
+	authMethods := 0
+	for _, configured := range []bool{useAccessKey, useToken, usePassword} {
+		if configured {
+			authMethods++
+		}
+	}
+	switch {
+	case authMethods > 1:
+		resp.Diagnostics.AddError(
+			"Conflicting JumpServer Authentication",
+			"More than one authentication method is configured. Set only one of username/password, token, "+
+				"or access_key_id/access_key_secret, either in the configuration or through their environment variables.",
 		)
+	case useToken:
+		// The token is used as is, no credentials are exchanged.
+	case useAccessKey:
+		if accessKeyID == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("access_key_id"),
+				"Missing JumpServer Access Key ID",
+				"access_key_secret is set but access_key_id is not. "+
+					"Set access_key_id in the configuration or the JUMP_SERVER_ACCESS_KEY_ID environment variable.",
+			)
+		}
+		if accessKeySecret == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("access_key_secret"),
+				"Missing JumpServer Access Key Secret",
+				"access_key_id is set but access_key_secret is not. "+
+					"Set access_key_secret in the configuration or the JUMP_SERVER_ACCESS_KEY_SECRET environment variable.",
+			)
+		}
+	default:
+		if username == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("username"),
+				"Missing JumpServer API Username",
+				"The provider cannot create the JumpServer API client as there is a missing or empty value for the JumpServer API username. "+
+					"The username value in the configuration takes precedence; if it is not set, the JUMP_SERVER_USERNAME environment variable is used. "+
+					"Set one of them to a non-empty value, or configure a token or an access key instead.",
+			)
+		}
+		if password == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("password"),
+				"Missing JumpServer API Password",
+				"The provider cannot create the JumpServer API client as there is a missing or empty value for the JumpServer API password. "+
+					"The password value in the configuration takes precedence; if it is not set, the JUMP_SERVER_PASSWORD environment variable is used. "+
+					"Set one of them to a non-empty value, or configure a token or an access key instead.",
+			)
+		}
+	}
+
+	requestTimeout := defaultRequestTimeout
+	if !data.RequestTimeout.IsNull() {
+		parsed, err := time.ParseDuration(data.RequestTimeout.ValueString())
+		if err != nil || parsed <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				fmt.Sprintf("request_timeout must be a positive duration such as \"30s\", got %q.", data.RequestTimeout.ValueString()),
+			)
+		}
+		requestTimeout = parsed
+	}
+
+	insecure := data.InsecureSkipVerify.ValueBool()
+	if data.InsecureSkipVerify.IsNull() {
+		if envValue := os.Getenv("JUMP_SERVER_INSECURE"); envValue != "" {
+			parsed, err := strconv.ParseBool(envValue)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("insecure_skip_verify"),
+					"Invalid JUMP_SERVER_INSECURE Value",
+					fmt.Sprintf("The JUMP_SERVER_INSECURE environment variable must be a boolean, got %q.", envValue),
+				)
+			}
+			insecure = parsed
+		}
 	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	token, err := getToken(baseURL, username, password)
+	transport, err := newHTTPTransport(data.CACertFile.ValueString(), data.CACertPEM.ValueString(), insecure)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to authenticate with JumpServer API",
-			fmt.Sprintf("An unexpected error occurred when trying to authenticate with the JumpServer API: %s", err.Error()),
+			"Invalid CA Certificate Bundle",
+			fmt.Sprintf("The provider cannot load the CA certificate bundle: %s", err.Error()),
 		)
 		return
 	}
+	if insecure {
+		tflog.Warn(ctx, "TLS certificate verification is disabled for the JumpServer API")
+	}
 
-	client := &http.Client{}
-	client.Transport = &authTransport{
-		Token:    token,
-		BaseURL:  baseURL,
-		Delegate: http.DefaultTransport,
+	// Access keys sign every request and a configured token is used as is;
+	// otherwise exchange the password for a token.
+	var tokenExpiry time.Time
+	if usePassword {
+		token, tokenExpiry, err = getToken(ctx, &http.Client{Transport: transport, Timeout: requestTimeout}, baseURL, username, password)
+		if err != nil {
+			warnIfRateLimited(&resp.Diagnostics, err, "authenticate")
+			resp.Diagnostics.AddError(
+				"Unable to authenticate with JumpServer API",
+				fmt.Sprintf("An unexpected error occurred when trying to authenticate with the JumpServer API: %s", err.Error()),
+			)
+			return
+		}
+	}
+
+	// A single client is shared by all resources and data sources so
+	// connections are reused and every request is bounded by the timeout.
+	httpClient := &http.Client{Timeout: requestTimeout}
+	httpClient.Transport = &authTransport{
+		Token:           token,
+		TokenExpiry:     tokenExpiry,
+		Username:        username,
+		Password:        password,
+		AccessKeyID:     accessKeyID,
+		AccessKeySecret: accessKeySecret,
+		OrgID:           orgID,
+		BaseURL:         baseURL,
+		Delegate:        transport,
+		Timeout:         requestTimeout,
+
+		DebugHTTP: os.Getenv(debugHTTPEnvVar) != "",
 	}
 
+	client := newJumpServerClient(httpClient, baseURL)
+	client.validateProtocolsFromAPI = data.ValidateProtocolsFromAPI.ValueBool()
+	client.defaultPlatform = data.DefaultPlatform.ValueString()
+	if !data.MaxConcurrency.IsNull() {
+		client.maxConcurrency = int(data.MaxConcurrency.ValueInt64())
+	}
+
+	// Version-aware behavior can branch on the detected version. Older
+	// servers without a version endpoint are left at "".
+	client.apiVersion = client.detectAPIVersion(ctx)
+	tflog.Debug(ctx, "Detected JumpServer API version", map[string]interface{}{"version": client.apiVersion})
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
-func getToken(baseURL, username, password string) (string, error) {
-	url := baseURL + "/api/v1/authentication/auth/"
-	credentials := map[string]string{
-		"username": username,
-		"password": password,
-	}
-	jsonValue, _ := json.Marshal(credentials)
-	resp, err := http.Post(url, "application/json", bytes.NewBuffer(jsonValue))
+// defaultRequestTimeout is used when request_timeout is not configured.
+const defaultRequestTimeout = 30 * time.Second
+
+// defaultMaxConcurrency is used when max_concurrency is not configured.
+const defaultMaxConcurrency = 8
+
+// normalizeBaseURL checks that baseURL is an absolute http or https URL and
+// strips trailing slashes, so API paths can be appended without producing
+// "//api/v1/...".
+func normalizeBaseURL(baseURL string) (string, error) {
+	normalized := strings.TrimRight(baseURL, "/")
+	parsed, err := url.Parse(normalized)
 	if err != nil {
 		return "", err
 	}
-	defer resp.Body.Close()
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return "", fmt.Errorf("the scheme must be http or https")
+	}
+	if parsed.Host == "" {
+		return "", fmt.Errorf("the URL has no host")
+	}
+	if parsed.RawQuery != "" || parsed.Fragment != "" {
+		return "", fmt.Errorf("the URL must not have a query or fragment")
+	}
+	return normalized, nil
+}
 
+// configOrEnv returns the configured attribute value, or the environment
+// variable when the attribute is not set in the configuration.
+func configOrEnv(ctx context.Context, value types.String, attribute, envVar string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+
+	envValue := os.Getenv(envVar)
+	if envValue != "" {
+		tflog.Trace(ctx, "Using provider attribute from environment variable", map[string]interface{}{
+			"attribute": attribute,
+			"env_var":   envVar,
+		})
+	}
+	return envValue
+}
+
+// newHTTPTransport returns the transport used for all JumpServer requests.
+// Certificates from caCertFile and caCertPEM are trusted in addition to the
+// system roots, and insecure disables certificate verification altogether.
+func newHTTPTransport(caCertFile, caCertPEM string, insecure bool) (*http.Transport, error) {
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+	if caCertFile == "" && caCertPEM == "" && !insecure {
+		return transport, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// Only set when explicitly requested through insecure_skip_verify.
+		InsecureSkipVerify: insecure,
+	}
+
+	if caCertFile != "" || caCertPEM != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		if caCertFile != "" {
+			pemData, err := os.ReadFile(caCertFile)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read %s: %w", caCertFile, err)
+			}
+			if !rootCAs.AppendCertsFromPEM(pemData) {
+				return nil, fmt.Errorf("%s does not contain any valid PEM certificates", caCertFile)
+			}
+		}
+		if caCertPEM != "" && !rootCAs.AppendCertsFromPEM([]byte(caCertPEM)) {
+			return nil, fmt.Errorf("ca_cert_pem does not contain any valid PEM certificates")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+// tokenRefreshWindow is how long before its expiry a token is renewed.
+const tokenRefreshWindow = 60 * time.Second
+
+// tokenExpiryLayouts are the date_expired formats used by JumpServer versions.
+var tokenExpiryLayouts = []string{
+	"2006/01/02 15:04:05 -0700",
+	"2006-01-02 15:04:05 -0700",
+	time.RFC3339,
+}
+
+// getToken exchanges username/password for a bearer token. The returned
+// expiry is zero when the server does not report a parsable one.
+func getToken(ctx context.Context, httpClient *http.Client, baseURL, username, password string) (string, time.Time, error) {
+	credentials := map[string]string{
+		"username": username,
+		"password": password,
+	}
 	var result map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return "", err
+	if err := client.New(httpClient, baseURL).Post(ctx, "/api/v1/authentication/auth/", credentials, &result); err != nil {
+		return "", time.Time{}, err
 	}
 
-	if token, ok := result["token"].(string); ok {
-		return token, nil
+	token, ok := result["token"].(string)
+	if !ok {
+		return "", time.Time{}, fmt.Errorf("unable to fetch token")
 	}
-	return "", fmt.Errorf("unable to fetch token")
+	var expiry time.Time
+	if dateExpired, ok := result["date_expired"].(string); ok {
+		for _, layout := range tokenExpiryLayouts {
+			if parsed, err := time.Parse(layout, dateExpired); err == nil {
+				expiry = parsed
+				break
+			}
+		}
+	}
+	return token, expiry, nil
 }
 
 type authTransport struct {
 	Token    string
 	BaseURL  string
 	Delegate http.RoundTripper
+
+	// TokenExpiry is when Token expires, zero when unknown. The token is
+	// renewed ahead of time within tokenRefreshWindow of it.
+	TokenExpiry time.Time
+
+	// Username and Password are kept to obtain a new Token when the
+	// current one is rejected.
+	Username string
+	Password string
+
+	// AccessKeyID and AccessKeySecret, when set, sign each request instead
+	// of sending the bearer Token.
+	AccessKeyID     string
+	AccessKeySecret string
+
+	// Timeout bounds the re-authentication request made on a 401.
+	Timeout time.Duration
+
+	// OrgID scopes every request to a JumpServer organization through the
+	// X-JMS-ORG header. Empty leaves the server default, and requests that
+	// already carry the header keep it.
+	OrgID string
+
+	// DebugHTTP logs every request and response, see debugHTTPEnvVar.
+	DebugHTTP bool
+
+	// mu guards Token and TokenExpiry while they are being refreshed.
+	mu sync.Mutex
 }
 
 func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header.Set("Authorization", "Bearer "+t.Token)
+	// A per-request organization set by the caller takes precedence.
+	if t.OrgID != "" && req.Header.Get(client.OrgHeader) == "" {
+		req.Header.Set(client.OrgHeader, t.OrgID)
+	}
+	if t.AccessKeyID != "" {
+		signRequest(req, t.AccessKeyID, t.AccessKeySecret, time.Now())
+		return t.send(req)
+	}
+
+	token, expiry := t.currentToken()
+	if t.Username != "" && !expiry.IsZero() && time.Until(expiry) < tokenRefreshWindow {
+		// Renew a token about to expire; on failure the current token is
+		// still tried and the 401 fallback below applies.
+		if refreshed, err := t.refreshToken(req.Context(), token); err == nil {
+			token = refreshed
+		} else {
+			tflog.Warn(req.Context(), "Unable to renew expiring JumpServer API token", map[string]interface{}{"error": err.Error()})
+		}
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := t.send(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || t.Username == "" {
+		return resp, err
+	}
+
+	// The token has expired: re-authenticate and retry the request once.
+	// Requests whose body cannot be replayed are returned as is.
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+
+	token, err = t.refreshToken(req.Context(), token)
+	if err != nil {
+		tflog.Warn(req.Context(), "Unable to refresh JumpServer API token", map[string]interface{}{"error": err.Error()})
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	retry.Header.Set("Authorization", "Bearer "+token)
+	return t.send(retry)
+}
+
+func (t *authTransport) send(req *http.Request) (*http.Response, error) {
+	if t.DebugHTTP {
+		return logRoundTrip(t.Delegate, req)
+	}
 	return t.Delegate.RoundTrip(req)
 }
 
+func (t *authTransport) currentToken() (string, time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.Token, t.TokenExpiry
+}
+
+// refreshToken obtains a new token unless another request already replaced
+// the rejected one while this one was waiting for the lock.
+func (t *authTransport) refreshToken(ctx context.Context, rejected string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.Token != rejected {
+		return t.Token, nil
+	}
+
+	token, expiry, err := getToken(ctx, &http.Client{Transport: t.Delegate, Timeout: t.Timeout}, t.BaseURL, t.Username, t.Password)
+	if err != nil {
+		return "", err
+	}
+	t.Token = token
+	t.TokenExpiry = expiry
+	return token, nil
+}
+
 func (p *JumpServerProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		AssetHostResource,
+		AssetWebResource,
+		AssetCloudResource,
 		AccountResource,
+		AssetDatabaseResource,
+		NodeResource,
+		UserResource,
+		UserGroupResource,
+		AssetPermissionResource,
+		DomainResource,
+		GatewayResource,
+		LabelResource,
+		CommandGroupResource,
+		CommandACLResource,
+		LoginACLResource,
+		AccountTemplateResource,
+		OrganizationResource,
+		RoleResource,
+		RoleBindingResource,
 	}
 }
 
 func (p *JumpServerProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewHostSuggestionsDataSource,
+		NewImportPlanDataSource,
+		NewConnectMethodsDataSource,
+		NewPlatformsDataSource,
+		NewAccountsDataSource,
+		NewNodesDataSource,
+		NewAssetHostDataSource,
+		NewUsersDataSource,
+		NewUserGroupsDataSource,
 	}
 }
 
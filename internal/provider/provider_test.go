@@ -0,0 +1,464 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	pathpkg "path"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate the provider during
+// acceptance testing. The factory function is called for each Terraform CLI
+// command to create a provider server that the CLI can connect to.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"jumpserver": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testToken is the token the test server hands out and expects on every
+// request after authentication.
+const testToken = "test-token"
+
+// testPlatformLinux is the ID of the "Linux" host platform every test server
+// starts with.
+const testPlatformLinux = "1"
+
+// testAccProviderConfig points the provider at the test server.
+func testAccProviderConfig(server *testServer) string {
+	return fmt.Sprintf(`
+provider "jumpserver" {
+  base_url = %q
+  username = "admin"
+  password = "admin"
+}
+`, server.URL)
+}
+
+// newTestClient returns a provider client authenticated against the test
+// server, for tests that call the client directly.
+func newTestClient(server *testServer) *jumpServerClient {
+	httpClient := &http.Client{Transport: &authTransport{Token: testToken, BaseURL: server.URL, Delegate: http.DefaultTransport}}
+	return newJumpServerClient(httpClient, server.URL)
+}
+
+// testRequest is a request received by the test server. Body is the decoded
+// JSON body, nil when the request has none.
+type testRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   interface{}
+}
+
+// testServer is an in-memory JumpServer API. Objects created with POST are
+// stored per collection path and served by the list and detail endpoints of
+// that collection; PATCH, PUT and DELETE update them. Like JumpServer, a
+// create sets the read-only date_created field and every write sets
+// date_updated. Endpoints that behave
+// differently are replaced with handle.
+type testServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	objects  map[string][]map[string]interface{}
+	handlers map[string]http.HandlerFunc
+	requests []testRequest
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	s := &testServer{
+		objects:  map[string][]map[string]interface{}{},
+		handlers: map[string]http.HandlerFunc{},
+	}
+	s.handle(http.MethodPost, "/api/v1/authentication/auth/", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, map[string]interface{}{"token": testToken})
+	})
+	s.put("/api/v1/assets/platforms/", map[string]interface{}{
+		"id":       1,
+		"name":     "Linux",
+		"category": map[string]interface{}{"value": "host", "label": "Host"},
+		"type":     map[string]interface{}{"value": "linux", "label": "Linux"},
+		"protocols": []interface{}{
+			map[string]interface{}{"name": "ssh", "port": 22},
+			map[string]interface{}{"name": "sftp", "port": 22},
+		},
+	})
+
+	s.Server = httptest.NewServer(s)
+	t.Cleanup(s.Close)
+	return s
+}
+
+// handle replaces the endpoint method path, e.g. "POST", "/api/v1/accounts/accounts/bulk/".
+func (s *testServer) handle(method, path string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[method+" "+path] = handler
+}
+
+// put stores obj in collection, assigning a UUID when it has no ID, and
+// returns the ID.
+func (s *testServer) put(collection string, obj map[string]interface{}) string {
+	obj = normalizeTestObject(obj)
+	if _, ok := obj["id"]; !ok {
+		obj["id"] = uuid.NewString()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[collection] = append(s.objects[collection], obj)
+	return fmt.Sprint(obj["id"])
+}
+
+// object returns a copy of the object with the given ID in collection.
+func (s *testServer) object(collection, id string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i := s.index(collection, id); i >= 0 {
+		return normalizeTestObject(s.objects[collection][i]), true
+	}
+	return nil, false
+}
+
+// update sets fields of the object with the given ID in collection, as a
+// PATCH would.
+func (s *testServer) update(collection, id string, fields map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i := s.index(collection, id); i >= 0 {
+		for key, value := range normalizeTestObject(fields) {
+			s.objects[collection][i][key] = value
+		}
+	}
+}
+
+// remove deletes the object with the given ID from collection.
+func (s *testServer) remove(collection, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i := s.index(collection, id); i >= 0 {
+		s.objects[collection] = append(s.objects[collection][:i], s.objects[collection][i+1:]...)
+	}
+}
+
+// list returns copies of the objects in collection.
+func (s *testServer) list(collection string) []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	objects := make([]map[string]interface{}, 0, len(s.objects[collection]))
+	for _, obj := range s.objects[collection] {
+		objects = append(objects, normalizeTestObject(obj))
+	}
+	return objects
+}
+
+// requestsTo returns the requests received for method and path, in order.
+func (s *testServer) requestsTo(method, path string) []testRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var matched []testRequest
+	for _, req := range s.requests {
+		if req.Method == method && req.Path == path {
+			matched = append(matched, req)
+		}
+	}
+	return matched
+}
+
+// index returns the position of the object with the given ID in collection,
+// or -1. The caller holds mu.
+func (s *testServer) index(collection, id string) int {
+	for i, obj := range s.objects[collection] {
+		if fmt.Sprint(obj["id"]) == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *testServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	raw, _ := io.ReadAll(r.Body)
+	var body interface{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &body); err != nil {
+			writeTestJSON(w, http.StatusBadRequest, map[string]interface{}{"detail": err.Error()})
+			return
+		}
+	}
+	r.Body = io.NopCloser(strings.NewReader(string(raw)))
+
+	s.mu.Lock()
+	s.requests = append(s.requests, testRequest{Method: r.Method, Path: r.URL.Path, Query: r.URL.Query(), Body: body})
+	handler, ok := s.handlers[r.Method+" "+r.URL.Path]
+	s.mu.Unlock()
+
+	if r.URL.Path != "/api/v1/authentication/auth/" && r.Header.Get("Authorization") != "Bearer "+testToken {
+		writeTestJSON(w, http.StatusUnauthorized, map[string]interface{}{"detail": "Authentication credentials were not provided."})
+		return
+	}
+	if ok {
+		handler(w, r)
+		return
+	}
+
+	// Detail endpoints end in an object ID, anything else is a collection.
+	collection, id := pathpkg.Split(strings.TrimSuffix(r.URL.Path, "/"))
+	if !isTestObjectID(id) {
+		s.serveCollection(w, r, body)
+		return
+	}
+	s.serveObject(w, r, collection, id, body)
+}
+
+func (s *testServer) serveCollection(w http.ResponseWriter, r *http.Request, body interface{}) {
+	switch r.Method {
+	case http.MethodGet:
+		var matched []map[string]interface{}
+		for _, obj := range s.list(r.URL.Path) {
+			if matchesTestQuery(obj, r.URL.Query()) {
+				matched = append(matched, obj)
+			}
+		}
+		if matched == nil {
+			matched = []map[string]interface{}{}
+		}
+		writeTestJSON(w, http.StatusOK, matched)
+	case http.MethodPost:
+		obj, ok := body.(map[string]interface{})
+		if !ok {
+			writeTestJSON(w, http.StatusBadRequest, map[string]interface{}{"detail": "expected a JSON object"})
+			return
+		}
+		if _, ok := obj["date_created"]; !ok {
+			obj["date_created"] = testTimestamp()
+		}
+		obj["date_updated"] = testTimestamp()
+		id := s.put(r.URL.Path, obj)
+		created, _ := s.object(r.URL.Path, id)
+		writeTestJSON(w, http.StatusCreated, created)
+	default:
+		writeTestJSON(w, http.StatusMethodNotAllowed, map[string]interface{}{"detail": "Method not allowed."})
+	}
+}
+
+func (s *testServer) serveObject(w http.ResponseWriter, r *http.Request, collection, id string, body interface{}) {
+	s.mu.Lock()
+	i := s.index(collection, id)
+	if i < 0 {
+		s.mu.Unlock()
+		writeTestJSON(w, http.StatusNotFound, map[string]interface{}{"detail": "Not found."})
+		return
+	}
+
+	obj := s.objects[collection][i]
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPatch, http.MethodPut:
+		fields, _ := body.(map[string]interface{})
+		if r.Method == http.MethodPut {
+			obj = map[string]interface{}{"id": obj["id"]}
+		}
+		for key, value := range normalizeTestObject(fields) {
+			if key != "id" {
+				obj[key] = value
+			}
+		}
+		obj["date_updated"] = testTimestamp()
+		s.objects[collection][i] = obj
+	case http.MethodDelete:
+		s.objects[collection] = append(s.objects[collection][:i], s.objects[collection][i+1:]...)
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+		return
+	default:
+		s.mu.Unlock()
+		writeTestJSON(w, http.StatusMethodNotAllowed, map[string]interface{}{"detail": "Method not allowed."})
+		return
+	}
+	obj = normalizeTestObject(obj)
+	s.mu.Unlock()
+	writeTestJSON(w, http.StatusOK, obj)
+}
+
+// testTimestamp returns the current time as JumpServer formats dates.
+func testTimestamp() string {
+	return time.Now().Format("2006/01/02 15:04:05.000000 -0700")
+}
+
+// isTestObjectID reports whether a path segment is an object ID, i.e. a UUID
+// or, for platforms, a number.
+func isTestObjectID(segment string) bool {
+	if _, err := uuid.Parse(segment); err == nil {
+		return true
+	}
+	_, err := strconv.Atoi(segment)
+	return err == nil
+}
+
+// matchesTestQuery reports whether obj has every filtered field. Fields
+// holding an object or a list match on the ID of the object or of any item.
+func matchesTestQuery(obj map[string]interface{}, query url.Values) bool {
+	for key := range query {
+		switch key {
+		case "limit", "offset", "search", "ordering":
+			continue
+		}
+		value, ok := obj[key]
+		if !ok || !matchesTestValue(value, query.Get(key)) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesTestValue(value interface{}, want string) bool {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return fmt.Sprint(v["id"]) == want
+	case []interface{}:
+		for _, item := range v {
+			if matchesTestValue(item, want) {
+				return true
+			}
+		}
+		return false
+	default:
+		return fmt.Sprint(v) == want
+	}
+}
+
+// normalizeTestObject returns a deep copy of obj as decoded from JSON, so
+// objects seeded from Go values compare like those received in requests.
+func normalizeTestObject(obj map[string]interface{}) map[string]interface{} {
+	copied := map[string]interface{}{}
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(raw, &copied); err != nil {
+		panic(err)
+	}
+	if copied == nil {
+		copied = map[string]interface{}{}
+	}
+	return copied
+}
+
+func writeTestJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestConfigOrEnv(t *testing.T) {
+	const envVar = "JUMP_SERVER_BASE_URL"
+	tests := []struct {
+		name   string
+		config types.String
+		env    string
+		want   string
+	}{
+		{name: "config only", config: types.StringValue("https://config.example.com"), want: "https://config.example.com"},
+		{name: "env only", config: types.StringNull(), env: "https://env.example.com", want: "https://env.example.com"},
+		{name: "config wins", config: types.StringValue("https://config.example.com"), env: "https://env.example.com", want: "https://config.example.com"},
+		{name: "neither", config: types.StringNull(), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(envVar, tt.env)
+			if got := configOrEnv(context.Background(), tt.config, "base_url", envVar); got != tt.want {
+				t.Errorf("configOrEnv() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccProvider_baseURLPrecedence(t *testing.T) {
+	server := newTestServer(t)
+	other := newTestServer(t)
+	listedBy := func(s *testServer) int { return len(s.requestsTo(http.MethodGet, "/api/v1/assets/hosts/")) }
+	const dataSource = `
+data "jumpserver_import_plan" "test" {}
+`
+	credentialsFromEnv := func() {
+		t.Setenv("JUMP_SERVER_USERNAME", "admin")
+		t.Setenv("JUMP_SERVER_PASSWORD", "admin")
+	}
+
+	t.Run("config only", func(t *testing.T) {
+		t.Setenv("JUMP_SERVER_BASE_URL", "")
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{Config: testAccProviderConfig(server) + dataSource},
+			},
+		})
+	})
+	t.Run("env only", func(t *testing.T) {
+		credentialsFromEnv()
+		t.Setenv("JUMP_SERVER_BASE_URL", other.URL)
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: `provider "jumpserver" {}` + dataSource,
+					Check: func(_ *terraform.State) error {
+						if listedBy(other) == 0 {
+							return fmt.Errorf("the server from JUMP_SERVER_BASE_URL received no requests")
+						}
+						return nil
+					},
+				},
+			},
+		})
+	})
+	t.Run("config wins", func(t *testing.T) {
+		t.Setenv("JUMP_SERVER_BASE_URL", other.URL)
+		before := listedBy(other)
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccProviderConfig(server) + dataSource,
+					Check: func(_ *terraform.State) error {
+						if listedBy(other) != before {
+							return fmt.Errorf("the server from JUMP_SERVER_BASE_URL was used although base_url is configured")
+						}
+						return nil
+					},
+				},
+			},
+		})
+	})
+	t.Run("neither", func(t *testing.T) {
+		credentialsFromEnv()
+		t.Setenv("JUMP_SERVER_BASE_URL", "")
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      `provider "jumpserver" {}` + dataSource,
+					ExpectError: regexp.MustCompile(`Missing JumpServer API Base URL`),
+				},
+			},
+		})
+	})
+}
@@ -1,36 +1,58 @@
 package provider
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
+	"net/url"
 	"strings"
 
-	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-jumpserver/internal/client"
 )
 
 var _ resource.Resource = &accountResource{}
+var _ resource.ResourceWithImportState = &accountResource{}
+var _ resource.ResourceWithModifyPlan = &accountResource{}
 
 // 资源结构体
 type accountResource struct {
-	client *http.Client
+	client *jumpServerClient
 }
 
 type JumpServerAccountModel struct {
-	//	Id         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`       // 必填
-	Username   types.String `tfsdk:"username"`   // 必填
-	Privileged types.Bool   `tfsdk:"privileged"` // 必填
-	Is_active  types.Bool   `tfsdk:"is_active"`  // 必填
-	Assets     types.List   `tfsdk:"assets"`     // 必填
+	ID         types.String `tfsdk:"id"`          // 计算属性
+	Name       types.String `tfsdk:"name"`        // 必填
+	Username   types.String `tfsdk:"username"`    // 必填
+	Privileged types.Bool   `tfsdk:"privileged"`  // 必填
+	Is_active  types.Bool   `tfsdk:"is_active"`   // 必填
+	SecretType types.String `tfsdk:"secret_type"` // 可选，默认 password
+	Secret     types.String `tfsdk:"secret"`      // 可选，敏感，不从 API 读取
+	Assets     types.List   `tfsdk:"assets"`      // 必填
+	OrgID      types.String `tfsdk:"org_id"`      // 可选，覆盖 provider 的 org_id
+}
+
+// 批量创建结果中表示成功的状态
+var bulkAccountSucceeded = map[string]bool{
+	"created": true,
+	"updated": true,
+	"ok":      true,
 }
 
+// API 字段对应的属性，用于按字段报告校验错误
+var accountFieldPaths = rootFieldPaths("name", "username", "privileged", "is_active", "secret_type", "secret", "assets")
+
 func AccountResource() resource.Resource {
 	return &accountResource{}
 }
@@ -44,11 +66,11 @@ func (r *accountResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*http.Client)
+	client, ok := req.ProviderData.(*jumpServerClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -59,6 +81,14 @@ func (r *accountResource) Configure(ctx context.Context, req resource.ConfigureR
 func (r *accountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				Description: "The ID of the account on the first asset in assets. " +
+					"Import with `terraform import jumpserver_account.example <uuid>`, or `<org_id>:<uuid>` to import from another organization",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the account",
@@ -75,14 +105,58 @@ func (r *accountResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Required:    true,
 				Description: "The nodes display of the asset host",
 			},
+			"secret_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("password"),
+				Description: "The kind of secret: `password` or `ssh_key`",
+				Validators: []validator.String{
+					stringvalidator.OneOf("password", "ssh_key"),
+				},
+			},
+			// The plugin framework in use has no write-only attributes, so the
+			// secret is kept in state but marked sensitive and never read back.
+			"secret": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "The password or SSH private key of the account. Changing it changes the secret of the account on every asset. " +
+					"The secret is never read back from JumpServer, so changes made outside Terraform are not detected",
+			},
 			"assets": schema.ListAttribute{
 				Required:    true,
 				ElementType: types.StringType,
+				Validators:  uuidListValidators,
+			},
+			"org_id": schema.StringAttribute{
+				Optional: true,
+				Description: "The ID of the organization the account is managed in. Overrides the provider's `org_id` " +
+					"for this resource; changing it replaces the account",
+				Validators: uuidValidators,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
 	}
 }
 
+// 计划阶段：资源 ID 是第一个资产上的账号，资产变化时 ID 在更新后才能确定
+func (r *accountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planAssets, stateAssets types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("assets"), &planAssets)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("assets"), &stateAssets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !planAssets.Equal(stateAssets) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	}
+}
+
 // 创建资源
 func (r *accountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan JumpServerAccountModel
@@ -92,97 +166,439 @@ func (r *accountResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	// 资产 ID 的 UUID 格式已由 schema 按元素校验
 	var validAssets []string
-	for _, asset := range plan.Assets.Elements() {
-		assetStr := asset.String()
-		// 去除两侧的引号或额外字符
-		assetStr = strings.Trim(assetStr, `“”"`)
-
-		// 验证 UUID 格式
-		if _, err := uuid.Parse(assetStr); err != nil {
-			resp.Diagnostics.AddError("Invalid UUID", fmt.Sprintf("Asset '%s' is not a valid UUID", assetStr))
+	resp.Diagnostics.Append(plan.Assets.ElementsAs(ctx, &validAssets, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating account", map[string]interface{}{
+		"name":     plan.Name.ValueString(),
+		"username": plan.Username.ValueString(),
+		"assets":   len(validAssets),
+	})
+
+	org := orgOption(plan.OrgID)
+	if !r.createOnAssets(ctx, plan, validAssets, org, &resp.Diagnostics) {
+		return
+	}
+
+	// 批量接口不返回账号 ID，按资产顺序取第一个创建成功的账号
+	ids, err := r.accountsByAsset(ctx, plan.Name.ValueString(), plan.Username.ValueString(), validAssets, org)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "look up created accounts", "account view", nil)
+		return
+	}
+	plan.ID = firstAccountID(validAssets, ids)
+	if len(validAssets) > 0 && plan.ID.IsNull() {
+		resp.Diagnostics.AddError("API Error", "Unable to find the created account on any of the assets")
+		return
+	}
+	tflog.Debug(ctx, "Created account", map[string]interface{}{"id": plan.ID.ValueString()})
+
+	// 更新 Terraform 状态
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// 通过批量接口在 assets 上创建账号。部分资产失败时记录错误并返回 true，
+// 调用方仍保存状态，资源被标记为 tainted；全部失败时返回 false
+func (r *accountResource) createOnAssets(ctx context.Context, plan JumpServerAccountModel, assets []string, org client.RequestOption, diags *diag.Diagnostics) bool {
+	payload := map[string]interface{}{
+		"name":        plan.Name.ValueString(),
+		"username":    plan.Username.ValueString(),
+		"privileged":  plan.Privileged.ValueBool(),
+		"is_active":   plan.Is_active.ValueBool(),
+		"secret_type": plan.SecretType.ValueString(),
+		"assets":      assets,
+	}
+	if !plan.Secret.IsNull() {
+		payload["secret"] = plan.Secret.ValueString()
+	}
+
+	// 从 API 响应中解析创建结果
+	var apiResponse []map[string]interface{}
+	if err := r.client.api.Post(ctx, "/api/v1/accounts/accounts/bulk/", payload, &apiResponse, org); err != nil {
+		addAPIError(diags, err, "create accounts", "account management", accountFieldPaths)
+		return false
+	}
+	// 批量接口按资产返回结果，如 [{"asset":"web(10.0.0.5)","state":"created","changed":true}]，
+	// 收集所有未成功的资产
+	var failures []string
+	for _, result := range apiResponse {
+		state := stringField(result, "state")
+		if bulkAccountSucceeded[state] {
+			continue
+		}
+		reason := stringField(result, "error")
+		if reason == "" {
+			reason = fmt.Sprintf("state %q", state)
+		}
+		failures = append(failures, fmt.Sprintf("%s: %s", stringField(result, "asset"), reason))
+	}
+	if len(failures) > 0 {
+		diags.AddError(
+			"Partial Account Creation Failure",
+			fmt.Sprintf("The account could not be created on %d of %d assets:\n  %s\n"+
+				"Accounts created on the other assets are kept and the resource is recorded as tainted.",
+				len(failures), len(apiResponse), strings.Join(failures, "\n  ")),
+		)
+	}
+	return len(failures) < len(apiResponse) || len(apiResponse) == 0
+}
+
+// 读取资源
+func (r *accountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerAccountModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.ID.IsNull() || state.ID.ValueString() == "" {
+		return
+	}
+
+	var account apiAccount
+	org := orgOption(state.OrgID)
+	err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/accounts/accounts/%s/", state.ID.ValueString()), nil, &account, org)
+	// 资源 ID 指向的账号已删除时改用其余资产上的账号，都已删除才从状态中移除
+	if client.IsNotFound(err) {
+		var found bool
+		found, err = r.readRemainingAccount(ctx, &state, &account, org)
+		if err == nil && !found {
+			resp.State.RemoveResource(ctx)
 			return
 		}
-		validAssets = append(validAssets, assetStr)
 	}
-	// 构建请求体
-	payload := map[string]interface{}{
-		"name":       plan.Name.ValueString(),
-		"username":   plan.Username.ValueString(),
-		"privileged": plan.Privileged.ValueBool(),
-		"is_active":  plan.Is_active.ValueBool(),
-		"assets":     validAssets,
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read accounts", "account view", nil)
+		return
+	}
+
+	state.Name = types.StringValue(account.Name)
+	state.Username = types.StringValue(account.Username)
+	state.Privileged = types.BoolValue(account.Privileged)
+	state.Is_active = types.BoolValue(account.IsActive)
+	if account.SecretType.Value != "" {
+		state.SecretType = types.StringValue(account.SecretType.Value)
 	}
 
-	// 将请求体转换为 JSON
-	jsonData, err := json.Marshal(payload)
+	// 只刷新状态中的资产，其他资产上的同名账号不属于本资源；导入时只有按 ID 导入的账号所在的资产
+	var priorAssets []string
+	if !state.Assets.IsNull() {
+		resp.Diagnostics.Append(state.Assets.ElementsAs(ctx, &priorAssets, false)...)
+	}
+	if len(priorAssets) == 0 && account.Asset.ID != "" {
+		priorAssets = []string{account.Asset.ID}
+	}
+	ids, err := r.accountsByAsset(ctx, account.Name, account.Username, priorAssets, org)
 	if err != nil {
-		resp.Diagnostics.AddError("Error marshaling request data", err.Error())
+		addAPIError(&resp.Diagnostics, err, "list account assets", "account view", nil)
+		return
+	}
+	assets := make([]string, 0, len(ids))
+	for _, asset := range priorAssets {
+		if _, ok := ids[asset]; ok {
+			assets = append(assets, asset)
+		}
+	}
+	assetsList, diags := types.ListValueFrom(ctx, types.StringType, assets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.Assets = assetsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
 
-	url := "http://172.30.9.65/api/v1/accounts/accounts/bulk/"
-	// 创建 HTTP 请求
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonData))
+// 按状态中的名称和用户名在其余资产上查找账号，找到时读取第一个并将资源 ID 指向它
+func (r *accountResource) readRemainingAccount(ctx context.Context, state *JumpServerAccountModel, account *apiAccount, org client.RequestOption) (bool, error) {
+	var assets []string
+	if !state.Assets.IsNull() {
+		if diags := state.Assets.ElementsAs(ctx, &assets, false); diags.HasError() {
+			return false, fmt.Errorf("unable to read assets from state")
+		}
+	}
+	ids, err := r.accountsByAsset(ctx, state.Name.ValueString(), state.Username.ValueString(), assets, org)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating HTTP request", err.Error())
+		return false, err
+	}
+	id := firstAccountID(assets, ids)
+	if id.IsNull() {
+		return false, nil
+	}
+
+	tflog.Debug(ctx, "Account not found, reading the account on another asset", map[string]interface{}{"id": state.ID.ValueString(), "fallback": id.ValueString()})
+	if err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/accounts/accounts/%s/", id.ValueString()), nil, account, org); err != nil {
+		return false, err
+	}
+	state.ID = id
+	return true, nil
+}
+
+// 导入资源：按 UUID 导入，或按 "<组织 ID>:<UUID>" 从指定组织导入
+func (r *accountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateWithOrg(ctx, req, resp)
+}
+
+// 更新资源：修改各资产上账号中变化的字段，在新增的资产上创建账号，删除移除的资产上的账号
+func (r *accountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerAccountModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priorAssets, plannedAssets []string
+	resp.Diagnostics.Append(state.Assets.ElementsAs(ctx, &priorAssets, false)...)
+	resp.Diagnostics.Append(plan.Assets.ElementsAs(ctx, &plannedAssets, false)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpReq.Header.Set("Content-Type", "application/json")
 
-	// 发送 HTTP 请求
-	httpResp, err := r.client.Do(httpReq)
+	// 只发送变化的字段
+	changes := map[string]interface{}{}
+	if !plan.Name.Equal(state.Name) {
+		changes["name"] = plan.Name.ValueString()
+	}
+	if !plan.Username.Equal(state.Username) {
+		changes["username"] = plan.Username.ValueString()
+	}
+	if !plan.Privileged.Equal(state.Privileged) {
+		changes["privileged"] = plan.Privileged.ValueBool()
+	}
+	if !plan.Is_active.Equal(state.Is_active) {
+		changes["is_active"] = plan.Is_active.ValueBool()
+	}
+	// 密文变化时修改各资产上账号的密文
+	secretChanged := !plan.Secret.Equal(state.Secret) || !plan.SecretType.Equal(state.SecretType)
+
+	org := orgOption(plan.OrgID)
+	ids, err := r.accountsByAsset(ctx, state.Name.ValueString(), state.Username.ValueString(), priorAssets, org)
 	if err != nil {
-		resp.Diagnostics.AddError("Error sending HTTP request", err.Error())
+		addAPIError(&resp.Diagnostics, err, "list account assets", "account view", nil)
 		return
 	}
-	defer httpResp.Body.Close()
 
-	// 检查响应状态码
-	if httpResp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(httpResp.Body)
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unexpected status code: %d, Response: %s", httpResp.StatusCode, string(body)))
+	planned := make(map[string]bool, len(plannedAssets))
+	for _, asset := range plannedAssets {
+		planned[asset] = true
+	}
+	var added []string
+	for _, asset := range plannedAssets {
+		if _, ok := ids[asset]; !ok {
+			added = append(added, asset)
+		}
+	}
+
+	for _, asset := range priorAssets {
+		id, ok := ids[asset]
+		if !ok {
+			continue
+		}
+		apiPath := fmt.Sprintf("/api/v1/accounts/accounts/%s/", id)
+		if !planned[asset] {
+			tflog.Debug(ctx, "Deleting account from removed asset", map[string]interface{}{"id": id, "asset": asset})
+			if err := r.client.api.Delete(ctx, apiPath, nil, nil, org); err != nil && !client.IsNotFound(err) {
+				addAPIError(&resp.Diagnostics, err, "delete accounts", "account management", nil)
+				return
+			}
+			continue
+		}
+		if len(changes) > 0 {
+			if err := r.client.api.Patch(ctx, apiPath, changes, nil, org); err != nil {
+				addAPIError(&resp.Diagnostics, err, "update accounts", "account management", accountFieldPaths)
+				return
+			}
+		}
+		if secretChanged {
+			if err := r.changeSecret(ctx, id, plan, org); err != nil {
+				addAPIError(&resp.Diagnostics, err, "change account secrets", "account management", accountFieldPaths)
+				return
+			}
+		}
+	}
+
+	if len(added) > 0 && !r.createOnAssets(ctx, plan, added, org, &resp.Diagnostics) {
 		return
 	}
 
-	// 从 API 响应中解析资源 ID（假设返回一个 ID 字段）
-	// 解析 API 响应
-	var apiResponse []map[string]interface{}
-	if err := json.NewDecoder(httpResp.Body).Decode(&apiResponse); err != nil {
-		resp.Diagnostics.AddError("Error decoding API response", err.Error())
+	// 资产变化时第一个资产可能已变化，重新确定资源 ID
+	if plan.ID.IsUnknown() {
+		ids, err = r.accountsByAsset(ctx, plan.Name.ValueString(), plan.Username.ValueString(), plannedAssets, org)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, err, "list account assets", "account view", nil)
+			return
+		}
+		plan.ID = firstAccountID(plannedAssets, ids)
+		if plan.ID.IsNull() {
+			resp.Diagnostics.AddError("API Error", "Unable to find the account on any of the assets")
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 修改账号的密文。密文为 null 时只修改密文类型，JumpServer 保留原密文
+func (r *accountResource) changeSecret(ctx context.Context, id string, plan JumpServerAccountModel, org client.RequestOption) error {
+	payload := map[string]interface{}{
+		"secret_type": plan.SecretType.ValueString(),
+	}
+	if !plan.Secret.IsNull() {
+		payload["secret"] = plan.Secret.ValueString()
+	}
+	tflog.Debug(ctx, "Changing account secret", map[string]interface{}{"id": id, "secret_type": plan.SecretType.ValueString()})
+	return r.client.api.Patch(ctx, fmt.Sprintf("/api/v1/accounts/accounts/%s/", id), payload, nil, org)
+}
+
+// 删除资源：删除账号在各资产上的副本
+func (r *accountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerAccountModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var assets []string
+	resp.Diagnostics.Append(state.Assets.ElementsAs(ctx, &assets, false)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	// 假设 API 响应为 [{"asset":"jumperServer(172.30.9.65)","state":"created","changed":true}]
-	if len(apiResponse) > 0 {
-		assetInfo := apiResponse[0]
 
-		// 如果创建成功，并且可以从响应中获取 asset 字段
-		if state, ok := assetInfo["state"].(string); ok && state == "created" {
-			// 在这里，可以选择记录状态、设置资源的其他属性
-			// 例如，将 "asset" 赋值给模型字段（可以忽略 SetId）
-			// 或者记录日志等
-		} else {
-			resp.Diagnostics.AddError("Invalid API response", "The response did not contain the expected data")
+	org := orgOption(state.OrgID)
+	ids, err := r.accountsByAsset(ctx, state.Name.ValueString(), state.Username.ValueString(), assets, org)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "list account assets", "account view", nil)
+		return
+	}
+	// 资源 ID 指向的账号即使已在 JumpServer 中改名也要删除
+	accountIDs := []string{state.ID.ValueString()}
+	for _, asset := range assets {
+		if id, ok := ids[asset]; ok && id != state.ID.ValueString() {
+			accountIDs = append(accountIDs, id)
+		}
+	}
+	for _, id := range accountIDs {
+		tflog.Debug(ctx, "Deleting account", map[string]interface{}{"id": id})
+		err := r.client.api.Delete(ctx, fmt.Sprintf("/api/v1/accounts/accounts/%s/", id), nil, nil, org)
+		// 已删除的账号视为成功
+		if err != nil && !client.IsNotFound(err) {
+			addAPIError(&resp.Diagnostics, err, "delete accounts", "account management", nil)
 			return
 		}
 	}
+}
 
-	// 更新 Terraform 状态
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
+// 按名称和用户名查询账号在 assets 上的 ID，按资产 ID 索引；其他资产上的同名账号被忽略
+func (r *accountResource) accountsByAsset(ctx context.Context, name, username string, assets []string, org client.RequestOption) (map[string]string, error) {
+	wanted := make(map[string]bool, len(assets))
+	for _, asset := range assets {
+		wanted[asset] = true
+	}
+	ids := make(map[string]string, len(assets))
+	if len(assets) == 0 {
+		return ids, nil
+	}
+
+	query := url.Values{}
+	query.Add("username", username)
+	query.Add("name", name)
+	accounts, err := r.client.listAccounts(ctx, query, org)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range accounts {
+		if wanted[account.Asset.ID] {
+			ids[account.Asset.ID] = account.ID
+		}
+	}
+	return ids, nil
 }
 
-// 读取资源
-func (r *accountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+// 按资产顺序返回第一个找到的账号 ID，都未找到时返回 null
+func firstAccountID(assets []string, ids map[string]string) types.String {
+	for _, asset := range assets {
+		if id, ok := ids[asset]; ok {
+			return types.StringValue(id)
+		}
+	}
+	return types.StringNull()
+}
 
+// API 返回的账号
+type apiAccount struct {
+	ID           string      `json:"id"`
+	Name         string      `json:"name"`
+	Username     string      `json:"username"`
+	Privileged   bool        `json:"privileged"`
+	IsActive     bool        `json:"is_active"`
+	SecretType   choiceField `json:"secret_type"`
+	Connectivity choiceField `json:"connectivity"`
+	Asset        objectRef   `json:"asset"`
 }
 
-// 更新资源
-func (r *accountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+// 对象引用：兼容 ID 字符串与 {"id": ..., "name": ...} 对象两种格式
+type objectRef struct {
+	ID string
+}
 
+func (a *objectRef) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &a.ID); err == nil {
+		return nil
+	}
+	var ref struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(data, &ref); err != nil {
+		return err
+	}
+	a.ID = ref.ID
+	return nil
 }
 
-// 删除资源
-func (r *accountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+// 取出对象引用中的 ID
+func refIDs(refs []objectRef) []string {
+	ids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		ids = append(ids, ref.ID)
+	}
+	return ids
+}
+
+// 查询账号列表
+func (c *jumpServerClient) listAccounts(ctx context.Context, query url.Values, opts ...client.RequestOption) ([]apiAccount, error) {
+	tflog.Debug(ctx, "Listing accounts", map[string]interface{}{"query": query.Encode()})
+	var accounts []apiAccount
+	if err := c.api.List(ctx, "/api/v1/accounts/accounts/?"+query.Encode(), &accounts, opts...); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// 按状态中列表的顺序排列 values，新增的值追加在末尾，避免仅顺序不同产生差异
+func orderLike(prior types.List, values []string) []string {
+	remaining := make(map[string]bool, len(values))
+	for _, v := range values {
+		remaining[v] = true
+	}
 
+	ordered := make([]string, 0, len(values))
+	for _, element := range prior.Elements() {
+		v, ok := element.(types.String)
+		if ok && remaining[v.ValueString()] {
+			ordered = append(ordered, v.ValueString())
+			delete(remaining, v.ValueString())
+		}
+	}
+	for _, v := range values {
+		if remaining[v] {
+			ordered = append(ordered, v)
+			delete(remaining, v)
+		}
+	}
+	return ordered
 }
@@ -0,0 +1,364 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testAccountsPath = "/api/v1/accounts/accounts/"
+
+// handleBulkAccounts serves the bulk account endpoint: one account is stored
+// per asset and a result is returned for each.
+func handleBulkAccounts(server *testServer) {
+	server.handle(http.MethodPost, testAccountsPath+"bulk/", func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			writeTestJSON(w, http.StatusBadRequest, map[string]interface{}{"detail": err.Error()})
+			return
+		}
+		assets, _ := payload["assets"].([]interface{})
+
+		results := make([]map[string]interface{}, 0, len(assets))
+		for _, asset := range assets {
+			server.put(testAccountsPath, map[string]interface{}{
+				"name":        payload["name"],
+				"username":    payload["username"],
+				"privileged":  payload["privileged"],
+				"is_active":   payload["is_active"],
+				"secret_type": payload["secret_type"],
+				"asset":       map[string]interface{}{"id": asset, "name": fmt.Sprintf("asset-%v", asset)},
+			})
+			results = append(results, map[string]interface{}{"asset": fmt.Sprintf("asset-%v", asset), "state": "created", "changed": true})
+		}
+		writeTestJSON(w, http.StatusCreated, results)
+	})
+}
+
+func TestAccAccountResource_create(t *testing.T) {
+	server := newTestServer(t)
+	handleBulkAccounts(server)
+	first := server.put(testHostsPath, map[string]interface{}{"name": "web-01", "address": "10.0.0.5"})
+	second := server.put(testHostsPath, map[string]interface{}{"name": "web-02", "address": "10.0.0.6"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccAccountConfig(true, first, second),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("jumpserver_account.test", "id"),
+					resource.TestCheckResourceAttr("jumpserver_account.test", "name", "deploy"),
+					resource.TestCheckResourceAttr("jumpserver_account.test", "username", "deploy"),
+					resource.TestCheckResourceAttr("jumpserver_account.test", "assets.#", "2"),
+					resource.TestCheckResourceAttr("jumpserver_account.test", "assets.0", first),
+					resource.TestCheckResourceAttr("jumpserver_account.test", "assets.1", second),
+					func(_ *terraform.State) error {
+						if got := len(server.list(testAccountsPath)); got != 2 {
+							return fmt.Errorf("%d accounts on the server, want 2", got)
+						}
+						// The asset UUIDs reach the API exactly as configured.
+						requests := server.requestsTo(http.MethodPost, testAccountsPath+"bulk/")
+						if len(requests) != 1 {
+							return fmt.Errorf("%d bulk requests, want 1", len(requests))
+						}
+						payload, _ := requests[0].Body.(map[string]interface{})
+						if got, want := fmt.Sprint(payload["assets"]), fmt.Sprint([]string{first, second}); got != want {
+							return fmt.Errorf("bulk assets = %s, want %s", got, want)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountConfig(active bool, assets ...string) string {
+	return fmt.Sprintf(`
+resource "jumpserver_account" "test" {
+  name       = "deploy"
+  username   = "deploy"
+  privileged = false
+  is_active  = %t
+  assets     = %s
+}
+`, active, testAccStringList(assets))
+}
+
+// testAccStringList formats values as an HCL list of strings.
+func testAccStringList(values []string) string {
+	quoted := "["
+	for i, v := range values {
+		if i > 0 {
+			quoted += ", "
+		}
+		quoted += fmt.Sprintf("%q", v)
+	}
+	return quoted + "]"
+}
+
+func TestAccAccountResource(t *testing.T) {
+	server := newTestServer(t)
+	handleBulkAccounts(server)
+	first := server.put(testHostsPath, map[string]interface{}{"name": "web-01", "address": "10.0.0.5"})
+	second := server.put(testHostsPath, map[string]interface{}{"name": "web-02", "address": "10.0.0.6"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if accounts := server.list(testAccountsPath); len(accounts) != 0 {
+				return fmt.Errorf("%d accounts left on the server, want 0", len(accounts))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccAccountConfig(true, first),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("jumpserver_account.test", "id"),
+					resource.TestCheckResourceAttr("jumpserver_account.test", "is_active", "true"),
+					resource.TestCheckResourceAttr("jumpserver_account.test", "assets.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "jumpserver_account.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Only the changed field is patched, and the account is added to the new asset.
+				Config: testAccProviderConfig(server) + testAccAccountConfig(false, first, second),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_account.test", "is_active", "false"),
+					resource.TestCheckResourceAttr("jumpserver_account.test", "assets.#", "2"),
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources["jumpserver_account.test"].Primary.ID
+						patches := server.requestsTo(http.MethodPatch, testAccountsPath+id+"/")
+						if len(patches) != 1 || fmt.Sprint(patches[0].Body) != "map[is_active:false]" {
+							return fmt.Errorf("PATCH requests = %v, want one setting only is_active", patches)
+						}
+						if got := len(server.list(testAccountsPath)); got != 2 {
+							return fmt.Errorf("%d accounts on the server, want 2", got)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Removing the first asset deletes its account; the ID moves to the remaining one.
+				Config: testAccProviderConfig(server) + testAccAccountConfig(false, second),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_account.test", "assets.#", "1"),
+					resource.TestCheckResourceAttr("jumpserver_account.test", "assets.0", second),
+					func(s *terraform.State) error {
+						accounts := server.list(testAccountsPath)
+						if len(accounts) != 1 {
+							return fmt.Errorf("%d accounts on the server, want 1", len(accounts))
+						}
+						if id := s.RootModule().Resources["jumpserver_account.test"].Primary.ID; id != fmt.Sprint(accounts[0]["id"]) {
+							return fmt.Errorf("id = %s, want the account on the remaining asset %v", id, accounts[0]["id"])
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountResource_sameNameOnOtherAsset(t *testing.T) {
+	server := newTestServer(t)
+	handleBulkAccounts(server)
+	managed := server.put(testHostsPath, map[string]interface{}{"name": "web-01", "address": "10.0.0.5"})
+	other := server.put(testHostsPath, map[string]interface{}{"name": "db-01", "address": "10.0.0.7"})
+	unrelated := server.put(testAccountsPath, map[string]interface{}{
+		"name":       "deploy",
+		"username":   "deploy",
+		"privileged": false,
+		"is_active":  true,
+		"asset":      map[string]interface{}{"id": other, "name": "db-01"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			accounts := server.list(testAccountsPath)
+			if len(accounts) != 1 || fmt.Sprint(accounts[0]["id"]) != unrelated {
+				return fmt.Errorf("accounts left on the server = %v, want only the unrelated account", accounts)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				// The account of the same name on another asset is not adopted, so
+				// the refresh after apply shows no diff.
+				Config: testAccProviderConfig(server) + testAccAccountConfig(true, managed),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_account.test", "assets.#", "1"),
+					resource.TestCheckResourceAttr("jumpserver_account.test", "assets.0", managed),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountResource_secret(t *testing.T) {
+	server := newTestServer(t)
+	handleBulkAccounts(server)
+	first := server.put(testHostsPath, map[string]interface{}{"name": "web-01", "address": "10.0.0.5"})
+	second := server.put(testHostsPath, map[string]interface{}{"name": "web-02", "address": "10.0.0.6"})
+	config := func(secret string) string {
+		return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "jumpserver_account" "test" {
+  name       = "deploy"
+  username   = "deploy"
+  privileged = false
+  is_active  = true
+  secret     = %q
+  assets     = %s
+}
+`, secret, testAccStringList([]string{first, second}))
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_account.test", "secret_type", "password"),
+					func(_ *terraform.State) error {
+						requests := server.requestsTo(http.MethodPost, testAccountsPath+"bulk/")
+						payload, _ := requests[0].Body.(map[string]interface{})
+						if payload["secret"] != "initial" || payload["secret_type"] != "password" {
+							return fmt.Errorf("bulk payload = %v, want the initial password", payload)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "jumpserver_account.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The secret is never read back, and an import holds only the
+				// asset of the imported account.
+				ImportStateVerifyIgnore: []string{"secret", "assets"},
+			},
+			{
+				// A new secret is set on the account of every asset, and nothing else changes.
+				Config: config("rotated"),
+				Check: func(_ *terraform.State) error {
+					accounts := server.list(testAccountsPath)
+					if len(accounts) != 2 {
+						return fmt.Errorf("%d accounts on the server, want 2", len(accounts))
+					}
+					for _, account := range accounts {
+						patches := server.requestsTo(http.MethodPatch, fmt.Sprintf("%s%v/", testAccountsPath, account["id"]))
+						if len(patches) != 1 || fmt.Sprint(patches[0].Body) != "map[secret:rotated secret_type:password]" {
+							return fmt.Errorf("PATCH requests for account %v = %v, want one changing the secret", account["id"], patches)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccAccountResource_firstAccountDeleted(t *testing.T) {
+	server := newTestServer(t)
+	handleBulkAccounts(server)
+	first := server.put(testHostsPath, map[string]interface{}{"name": "web-01", "address": "10.0.0.5"})
+	second := server.put(testHostsPath, map[string]interface{}{"name": "web-02", "address": "10.0.0.6"})
+	var firstAccount string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccAccountConfig(true, first, second),
+				Check: func(s *terraform.State) error {
+					firstAccount = s.RootModule().Resources["jumpserver_account.test"].Primary.ID
+					return nil
+				},
+			},
+			{
+				// The account on the other asset keeps the resource in state.
+				PreConfig: func() {
+					server.remove(testAccountsPath, firstAccount)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_account.test", "assets.#", "1"),
+					resource.TestCheckResourceAttr("jumpserver_account.test", "assets.0", second),
+					func(s *terraform.State) error {
+						accounts := server.list(testAccountsPath)
+						if id := s.RootModule().Resources["jumpserver_account.test"].Primary.ID; len(accounts) != 1 || id != fmt.Sprint(accounts[0]["id"]) {
+							return fmt.Errorf("id = %s, want the account on the remaining asset", id)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Applying recreates the account on the first asset.
+				Config: testAccProviderConfig(server) + testAccAccountConfig(true, first, second),
+				Check: func(_ *terraform.State) error {
+					if got := len(server.list(testAccountsPath)); got != 2 {
+						return fmt.Errorf("%d accounts on the server, want 2", got)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccAccountResource_importExisting(t *testing.T) {
+	server := newTestServer(t)
+	asset := server.put(testHostsPath, map[string]interface{}{"name": "web-01", "address": "10.0.0.5"})
+	// An account created in JumpServer, as the API returns it.
+	id := server.put(testAccountsPath, map[string]interface{}{
+		"name":        "deploy",
+		"username":    "deploy",
+		"privileged":  false,
+		"is_active":   true,
+		"secret_type": map[string]interface{}{"value": "ssh_key", "label": "SSH key"},
+		"asset":       map[string]interface{}{"id": asset, "name": "web-01"},
+	})
+	config := testAccProviderConfig(server) + fmt.Sprintf(`
+resource "jumpserver_account" "test" {
+  name        = "deploy"
+  username    = "deploy"
+  privileged  = false
+  is_active   = true
+  secret_type = "ssh_key"
+  assets      = [%q]
+}
+`, asset)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             config,
+				ResourceName:       "jumpserver_account.test",
+				ImportState:        true,
+				ImportStateId:      id,
+				ImportStatePersist: true,
+			},
+			{
+				// The imported account matches the configuration.
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
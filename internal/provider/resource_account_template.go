@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+var _ resource.Resource = &accountTemplateResource{}
+var _ resource.ResourceWithImportState = &accountTemplateResource{}
+
+// 资源结构体
+type accountTemplateResource struct {
+	client *jumpServerClient
+}
+
+func AccountTemplateResource() resource.Resource {
+	return &accountTemplateResource{}
+}
+
+type JumpServerAccountTemplateResourceModel struct {
+	ID         types.String `tfsdk:"id"`          // 只读
+	Name       types.String `tfsdk:"name"`        // 必填
+	Username   types.String `tfsdk:"username"`    // 必填
+	SecretType types.String `tfsdk:"secret_type"` // 可选，默认 password
+	Secret     types.String `tfsdk:"secret"`      // 可选，敏感，不从 API 读取
+	Privileged types.Bool   `tfsdk:"privileged"`  // 可选，默认 false
+	AutoPush   types.Bool   `tfsdk:"auto_push"`   // 可选，默认 false
+}
+
+// API 字段对应的属性，用于按字段报告校验错误
+var accountTemplateFieldPaths = rootFieldPaths("name", "username", "secret_type", "secret", "privileged", "auto_push")
+
+// 账号模板接口返回的字段，密文不会返回
+type apiAccountTemplate struct {
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	Username   string      `json:"username"`
+	SecretType choiceField `json:"secret_type"`
+	Privileged bool        `json:"privileged"`
+	AutoPush   bool        `json:"auto_push"`
+}
+
+func (r *accountTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_template"
+}
+
+func (r *accountTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *accountTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the account template. Import with `terraform import jumpserver_account_template.example <uuid>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the account template",
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "The username of accounts created from the template",
+			},
+			"secret_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("password"),
+				Description: "The kind of secret: `password` or `ssh_key`",
+				Validators: []validator.String{
+					stringvalidator.OneOf("password", "ssh_key"),
+				},
+			},
+			// The plugin framework in use has no write-only attributes, so the
+			// secret is kept in state but marked sensitive and never read back.
+			"secret": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "The password or SSH private key of the template. " +
+					"The secret is never read back from JumpServer, so changes made outside Terraform are not detected",
+			},
+			"privileged": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether accounts created from the template are privileged",
+			},
+			"auto_push": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether accounts created from the template are pushed to their assets automatically",
+			},
+		},
+	}
+}
+
+// 由计划构造创建/更新请求体，未设置密文时不发送
+func buildAccountTemplatePayload(plan JumpServerAccountTemplateResourceModel) map[string]interface{} {
+	payload := map[string]interface{}{
+		"name":        plan.Name.ValueString(),
+		"username":    plan.Username.ValueString(),
+		"secret_type": plan.SecretType.ValueString(),
+		"privileged":  plan.Privileged.ValueBool(),
+		"auto_push":   plan.AutoPush.ValueBool(),
+	}
+	if !plan.Secret.IsNull() {
+		payload["secret"] = plan.Secret.ValueString()
+	}
+	return payload
+}
+
+// 创建资源
+func (r *accountTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerAccountTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var template apiAccountTemplate
+	if err := r.client.api.Post(ctx, "/api/v1/accounts/account-templates/", buildAccountTemplatePayload(plan), &template); err != nil {
+		addAPIError(&resp.Diagnostics, err, "create account templates", "account management", accountTemplateFieldPaths)
+		return
+	}
+	if template.ID == "" {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve account template ID from response")
+		return
+	}
+	plan.ID = types.StringValue(template.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 读取资源
+func (r *accountTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerAccountTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var template apiAccountTemplate
+	err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/accounts/account-templates/%s/", state.ID.ValueString()), nil, &template)
+	// 账号模板已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read account templates", "account view", nil)
+		return
+	}
+
+	state.Name = types.StringValue(template.Name)
+	state.Username = types.StringValue(template.Username)
+	state.SecretType = types.StringValue(template.SecretType.Value)
+	state.Privileged = types.BoolValue(template.Privileged)
+	state.AutoPush = types.BoolValue(template.AutoPush)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// 导入资源：按 UUID 导入，其余属性由 Read 填充
+func (r *accountTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// 更新资源
+func (r *accountTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerAccountTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+
+	apiPath := fmt.Sprintf("/api/v1/accounts/account-templates/%s/", state.ID.ValueString())
+	if err := r.client.api.Patch(ctx, apiPath, buildAccountTemplatePayload(plan), nil); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update account templates", "account management", accountTemplateFieldPaths)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 删除资源
+func (r *accountTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerAccountTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/accounts/account-templates/%s/", state.ID.ValueString())
+	if err := r.client.api.Delete(ctx, apiPath, nil, nil); err != nil && !client.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, err, "delete account templates", "account management", nil)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,498 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+var (
+	_ resource.Resource                     = &assetPermissionResource{}
+	_ resource.ResourceWithConfigValidators = &assetPermissionResource{}
+	_ resource.ResourceWithImportState      = &assetPermissionResource{}
+)
+
+// 资源结构体
+type assetPermissionResource struct {
+	client *jumpServerClient
+}
+
+func AssetPermissionResource() resource.Resource {
+	return &assetPermissionResource{}
+}
+
+type JumpServerAssetPermissionResourceModel struct {
+	ID          types.String `tfsdk:"id"`           // 只读
+	Name        types.String `tfsdk:"name"`         // 必填
+	Users       types.List   `tfsdk:"users"`        // 可选，用户 ID
+	UserGroups  types.List   `tfsdk:"user_groups"`  // 可选，用户组 ID
+	Assets      types.List   `tfsdk:"assets"`       // 可选，资产 ID
+	Nodes       types.List   `tfsdk:"nodes"`        // 可选，节点 ID
+	Accounts    types.List   `tfsdk:"accounts"`     // 可选，账号名或 @ALL 等特殊值
+	Protocols   types.List   `tfsdk:"protocols"`    // 可选，默认 ["all"]
+	Actions     types.List   `tfsdk:"actions"`      // 可选，默认全部动作
+	DateStart   types.String `tfsdk:"date_start"`   // 可选，RFC3339
+	DateExpired types.String `tfsdk:"date_expired"` // 可选，RFC3339
+	OrgID       types.String `tfsdk:"org_id"`       // 可选，覆盖 provider 的 org_id
+}
+
+// API 字段对应的属性，用于按字段报告校验错误
+var assetPermissionFieldPaths = rootFieldPaths("name", "users", "user_groups", "assets", "nodes", "accounts", "protocols", "actions", "date_start", "date_expired")
+
+// 授权规则接口返回的字段
+type apiAssetPermission struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Users       []objectRef   `json:"users"`
+	UserGroups  []objectRef   `json:"user_groups"`
+	Assets      []objectRef   `json:"assets"`
+	Nodes       []objectRef   `json:"nodes"`
+	Accounts    []string      `json:"accounts"`
+	Protocols   []string      `json:"protocols"`
+	Actions     []choiceField `json:"actions"`
+	DateStart   string        `json:"date_start"`
+	DateExpired string        `json:"date_expired"`
+	DateCreated string        `json:"date_created"`
+}
+
+// 授权规则允许的动作，未配置 actions 时授予全部动作
+var permissionActions = []string{"connect", "upload", "download", "copy", "paste", "delete", "share"}
+
+// 字符串列表默认值
+func stringListDefault(values ...string) types.List {
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// 未指定时 JumpServer 以创建时间为生效时间，过期时间为创建后 70 年；
+// 生效时间与创建时间相差不超过 permissionDefaultStartSkew 时视为默认值
+const (
+	permissionDefaultStartSkew   = time.Minute
+	permissionDefaultExpiryYears = 70
+)
+
+// JumpServer 返回时间时可能使用的格式
+var permissionTimeLayouts = []string{
+	time.RFC3339,
+	"2006/01/02 15:04:05 -0700",
+	"2006-01-02 15:04:05 -0700",
+}
+
+func (r *assetPermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_permission"
+}
+
+func (r *assetPermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *assetPermissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				Description: "The ID of the asset permission. " +
+					"Import with `terraform import jumpserver_asset_permission.example <uuid>`, or `<org_id>:<uuid>` to import from another organization",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the asset permission",
+			},
+			"users": schema.ListAttribute{
+				Optional:    true,
+				Description: "The IDs of the users granted the permission",
+				ElementType: types.StringType,
+				Validators:  uuidListValidators,
+			},
+			"user_groups": schema.ListAttribute{
+				Optional:    true,
+				Description: "The IDs of the user groups granted the permission",
+				ElementType: types.StringType,
+				Validators:  uuidListValidators,
+			},
+			"assets": schema.ListAttribute{
+				Optional:    true,
+				Description: "The IDs of the assets the permission grants access to",
+				ElementType: types.StringType,
+				Validators:  uuidListValidators,
+			},
+			"nodes": schema.ListAttribute{
+				Optional:    true,
+				Description: "The IDs of the nodes whose assets the permission grants access to",
+				ElementType: types.StringType,
+				Validators:  uuidListValidators,
+			},
+			"accounts": schema.ListAttribute{
+				Optional:    true,
+				Description: "The accounts that can be used, by username or a special value such as `@ALL` or `@SPEC`. Removing it clears the accounts",
+				ElementType: types.StringType,
+			},
+			"protocols": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     listdefault.StaticValue(stringListDefault("all")),
+				Description: "The protocols that can be used, or `all`. Defaults to `[\"all\"]`",
+				ElementType: types.StringType,
+			},
+			"actions": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     listdefault.StaticValue(stringListDefault(permissionActions...)),
+				Description: "The actions allowed: `connect`, `upload`, `download`, `copy`, `paste`, `delete` or `share`. Defaults to all of them",
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(permissionActions...)),
+				},
+			},
+			"date_start": schema.StringAttribute{
+				Optional:    true,
+				Description: "The RFC3339 time the permission becomes effective. Leave unset to let JumpServer use the creation time; removing it resets the permission to its creation time",
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"date_expired": schema.StringAttribute{
+				Optional:    true,
+				Description: "The RFC3339 time the permission expires, which must be after `date_start`. Leave unset for no expiry; removing it restores JumpServer's default expiry, 70 years ahead",
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Optional: true,
+				Description: "The ID of the organization the asset permission is managed in. Overrides the provider's `org_id` " +
+					"for this resource; changing it replaces the asset permission",
+				Validators: uuidValidators,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// 创建资源
+func (r *assetPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerAssetPermissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := buildAssetPermissionPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var perm apiAssetPermission
+	if err := r.client.api.Post(ctx, "/api/v1/perms/asset-permissions/", payload, &perm, orgOption(plan.OrgID)); err != nil {
+		addAPIError(&resp.Diagnostics, err, "create asset permissions", "permission management", assetPermissionFieldPaths)
+		return
+	}
+	if perm.ID == "" {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve asset permission ID from response")
+		return
+	}
+	plan.ID = types.StringValue(perm.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 配置校验：过期时间须晚于生效时间
+func (r *assetPermissionResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		permissionDateRangeValidator{},
+	}
+}
+
+var _ resource.ConfigValidator = permissionDateRangeValidator{}
+
+// permissionDateRangeValidator 要求同时设置 date_start 与 date_expired 时，date_expired 晚于 date_start
+type permissionDateRangeValidator struct{}
+
+func (v permissionDateRangeValidator) Description(_ context.Context) string {
+	return "date_expired must be after date_start"
+}
+
+func (v permissionDateRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v permissionDateRangeValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var dateStart, dateExpired types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("date_start"), &dateStart)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("date_expired"), &dateExpired)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if dateStart.IsNull() || dateStart.IsUnknown() || dateExpired.IsNull() || dateExpired.IsUnknown() {
+		return
+	}
+
+	// 格式错误由属性校验器报告
+	start, err := time.Parse(time.RFC3339, dateStart.ValueString())
+	if err != nil {
+		return
+	}
+	expired, err := time.Parse(time.RFC3339, dateExpired.ValueString())
+	if err != nil {
+		return
+	}
+	if !expired.After(start) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("date_expired"),
+			"Invalid Permission Time Range",
+			fmt.Sprintf("date_expired (%s) must be after date_start (%s).", dateExpired.ValueString(), dateStart.ValueString()),
+		)
+	}
+}
+
+// 由计划构造创建/更新请求体。ID 列表总是发送，以便更新时能清空；
+// 其余未设置的字段不发送，使用 JumpServer 的默认值
+func buildAssetPermissionPayload(ctx context.Context, plan JumpServerAssetPermissionResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	payload := map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	}
+	idLists := map[string]types.List{
+		"users":       plan.Users,
+		"user_groups": plan.UserGroups,
+		"assets":      plan.Assets,
+		"nodes":       plan.Nodes,
+	}
+	for key, list := range idLists {
+		ids := []string{}
+		if !list.IsNull() {
+			diags.Append(list.ElementsAs(ctx, &ids, false)...)
+		}
+		payload[key] = ids
+	}
+
+	optionalLists := map[string]types.List{
+		"accounts":  plan.Accounts,
+		"protocols": plan.Protocols,
+		"actions":   plan.Actions,
+	}
+	for key, list := range optionalLists {
+		if list.IsNull() {
+			continue
+		}
+		var values []string
+		diags.Append(list.ElementsAs(ctx, &values, false)...)
+		payload[key] = values
+	}
+
+	// 未设置的时间不发送，JumpServer 拒绝空字符串；不设置过期时间即永不过期
+	if !plan.DateStart.IsNull() {
+		payload["date_start"] = plan.DateStart.ValueString()
+	}
+	if !plan.DateExpired.IsNull() {
+		payload["date_expired"] = plan.DateExpired.ValueString()
+	}
+	return payload, diags
+}
+
+// 读取资源
+func (r *assetPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerAssetPermissionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var perm apiAssetPermission
+	err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/perms/asset-permissions/%s/", state.ID.ValueString()), nil, &perm, orgOption(state.OrgID))
+	// 授权规则已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read asset permissions", "permission view", nil)
+		return
+	}
+
+	state.Name = types.StringValue(perm.Name)
+	state.Users = refreshIDList(ctx, &resp.Diagnostics, state.Users, refIDs(perm.Users))
+	state.UserGroups = refreshIDList(ctx, &resp.Diagnostics, state.UserGroups, refIDs(perm.UserGroups))
+	state.Assets = refreshIDList(ctx, &resp.Diagnostics, state.Assets, refIDs(perm.Assets))
+	state.Nodes = refreshIDList(ctx, &resp.Diagnostics, state.Nodes, refIDs(perm.Nodes))
+
+	// accounts 默认为空，状态为 null 且服务端为空时保持 null；导入时恢复服务端的账号
+	state.Accounts = refreshIDList(ctx, &resp.Diagnostics, state.Accounts, perm.Accounts)
+	// protocols 与 actions 有默认值，总是刷新
+	state.Protocols = refreshIDList(ctx, &resp.Diagnostics, state.Protocols, perm.Protocols)
+	actions := make([]string, 0, len(perm.Actions))
+	for _, action := range perm.Actions {
+		actions = append(actions, action.Value)
+	}
+	state.Actions = refreshIDList(ctx, &resp.Diagnostics, state.Actions, actions)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// 状态为 null 时（未配置或导入）仅恢复非默认的时间
+	created, hasCreated := parsePermissionTime(perm.DateCreated)
+	state.DateStart = restorePermissionTime(state.DateStart, perm.DateStart, func(t time.Time) bool {
+		return hasCreated && t.Sub(created).Abs() <= permissionDefaultStartSkew
+	})
+	state.DateExpired = restorePermissionTime(state.DateExpired, perm.DateExpired, func(t time.Time) bool {
+		// 允许一年误差：更新时重新设置的默认过期时间以更新时间为起点
+		return hasCreated && !t.Before(created.AddDate(permissionDefaultExpiryYears-1, 0, 0))
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// 导入资源：按 UUID 导入，或按 "<组织 ID>:<UUID>" 从指定组织导入
+func (r *assetPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateWithOrg(ctx, req, resp)
+}
+
+// 更新资源
+func (r *assetPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerAssetPermissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+
+	payload, diags := buildAssetPermissionPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/perms/asset-permissions/%s/", state.ID.ValueString())
+	if err := r.resetRemovedFields(ctx, apiPath, payload, plan, state); err != nil {
+		addAPIError(&resp.Diagnostics, err, "read asset permissions", "permission view", nil)
+		return
+	}
+	if err := r.client.api.Patch(ctx, apiPath, payload, nil, orgOption(plan.OrgID)); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update asset permissions", "permission management", assetPermissionFieldPaths)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 从配置中移除的 accounts 与时间不在请求体中，PATCH 不会清除服务端的旧值；
+// 此时显式发送 JumpServer 的默认值：空账号列表、创建时间与 70 年后过期
+func (r *assetPermissionResource) resetRemovedFields(ctx context.Context, apiPath string, payload map[string]interface{}, plan, state JumpServerAssetPermissionResourceModel) error {
+	if plan.Accounts.IsNull() && !state.Accounts.IsNull() {
+		payload["accounts"] = []string{}
+	}
+	if plan.DateExpired.IsNull() && !state.DateExpired.IsNull() {
+		payload["date_expired"] = time.Now().AddDate(permissionDefaultExpiryYears, 0, 0).Format(time.RFC3339)
+	}
+	if plan.DateStart.IsNull() && !state.DateStart.IsNull() {
+		var perm apiAssetPermission
+		if err := r.client.api.Get(ctx, apiPath, nil, &perm, orgOption(plan.OrgID)); err != nil {
+			return err
+		}
+		if created, ok := parsePermissionTime(perm.DateCreated); ok {
+			payload["date_start"] = created.Format(time.RFC3339)
+		}
+	}
+	return nil
+}
+
+// 删除资源
+func (r *assetPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerAssetPermissionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/perms/asset-permissions/%s/", state.ID.ValueString())
+	if err := r.client.api.Delete(ctx, apiPath, nil, nil, orgOption(state.OrgID)); err != nil && !client.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, err, "delete asset permissions", "permission management", nil)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// 刷新列表属性：按状态中的顺序排列；状态为 null 且服务端为空时保持 null
+func refreshIDList(ctx context.Context, diags *diag.Diagnostics, prior types.List, values []string) types.List {
+	if len(values) == 0 && prior.IsNull() {
+		return prior
+	}
+	list, d := types.ListValueFrom(ctx, types.StringType, orderLike(prior, values))
+	diags.Append(d...)
+	return list
+}
+
+// 刷新时间属性：与状态中的值表示同一时刻时保留状态中的写法，避免仅格式不同产生差异
+func refreshPermissionTime(prior types.String, server string) types.String {
+	serverTime, ok := parsePermissionTime(server)
+	if !ok {
+		return types.StringValue(server)
+	}
+	if priorTime, ok := parsePermissionTime(prior.ValueString()); ok && priorTime.Equal(serverTime) {
+		return prior
+	}
+	return types.StringValue(serverTime.Format(time.RFC3339))
+}
+
+// 刷新可选的时间属性：状态中有值时照常刷新；状态为 null 时，服务端的值不是
+// JumpServer 的默认值才恢复，未配置时间的授权规则刷新后不产生差异
+func restorePermissionTime(prior types.String, server string, isDefault func(time.Time) bool) types.String {
+	if !prior.IsNull() {
+		return refreshPermissionTime(prior, server)
+	}
+	serverTime, ok := parsePermissionTime(server)
+	if !ok || isDefault(serverTime) {
+		return prior
+	}
+	return types.StringValue(serverTime.Format(time.RFC3339))
+}
+
+// 按 JumpServer 可能返回的格式解析时间
+func parsePermissionTime(value string) (time.Time, bool) {
+	for _, layout := range permissionTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
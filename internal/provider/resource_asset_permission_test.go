@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testAssetPermissionsPath = "/api/v1/perms/asset-permissions/"
+
+func TestAccAssetPermissionResource_import(t *testing.T) {
+	server := newTestServer(t)
+	created := time.Date(2024, 3, 1, 8, 0, 0, 0, time.FixedZone("CST", 8*60*60))
+	users := []string{server.put("/api/v1/users/users/", map[string]interface{}{"username": "alice"}), server.put("/api/v1/users/users/", map[string]interface{}{"username": "bob"})}
+	groups := []string{server.put("/api/v1/users/groups/", map[string]interface{}{"name": "ops"}), server.put("/api/v1/users/groups/", map[string]interface{}{"name": "dba"})}
+	assets := []string{server.put(testHostsPath, map[string]interface{}{"name": "web-01"}), server.put(testHostsPath, map[string]interface{}{"name": "db-01"})}
+	nodes := []string{server.put("/api/v1/assets/nodes/", map[string]interface{}{"value": "prod"}), server.put("/api/v1/assets/nodes/", map[string]interface{}{"value": "staging"})}
+
+	refs := func(ids []string) []interface{} {
+		values := make([]interface{}, 0, len(ids))
+		for _, id := range ids {
+			values = append(values, map[string]interface{}{"id": id, "name": "ref-" + id})
+		}
+		return values
+	}
+	const jumpServerTime = "2006/01/02 15:04:05 -0700"
+
+	// A permission as JumpServer returns it: subjects and objects as references,
+	// actions as choices and dates in JumpServer's format.
+	restricted := server.put(testAssetPermissionsPath, map[string]interface{}{
+		"name":         "prod-ops",
+		"users":        refs(users),
+		"user_groups":  refs(groups),
+		"assets":       refs(assets),
+		"nodes":        refs(nodes),
+		"accounts":     []string{"root", "@SPEC"},
+		"protocols":    []string{"ssh", "sftp"},
+		"actions":      testPermissionActions([]string{"connect", "upload"}),
+		"date_created": created.Format(jumpServerTime),
+		"date_start":   "2024/04/01 00:00:00 +0000",
+		"date_expired": "2025/04/01 00:00:00 +0000",
+	})
+	// A permission created without accounts or dates holds JumpServer's defaults.
+	defaults := server.put(testAssetPermissionsPath, map[string]interface{}{
+		"name":         "staging-all",
+		"users":        refs(users[:1]),
+		"user_groups":  []interface{}{},
+		"assets":       []interface{}{},
+		"nodes":        refs(nodes[1:]),
+		"accounts":     []string{},
+		"protocols":    []string{"all"},
+		"actions":      testPermissionActions(permissionActions),
+		"date_created": created.Format(jumpServerTime),
+		"date_start":   created.Add(time.Second).Format(jumpServerTime),
+		"date_expired": created.AddDate(70, 0, 0).Format(jumpServerTime),
+	})
+
+	config := testAccProviderConfig(server) + fmt.Sprintf(`
+resource "jumpserver_asset_permission" "restricted" {
+  name         = "prod-ops"
+  users        = %s
+  user_groups  = %s
+  assets       = %s
+  nodes        = %s
+  accounts     = ["root", "@SPEC"]
+  protocols    = ["ssh", "sftp"]
+  actions      = ["connect", "upload"]
+  date_start   = "2024-04-01T00:00:00Z"
+  date_expired = "2025-04-01T00:00:00Z"
+}
+
+resource "jumpserver_asset_permission" "defaults" {
+  name  = "staging-all"
+  users = %s
+  nodes = %s
+}
+`, testAccStringList(users), testAccStringList(groups), testAccStringList(assets), testAccStringList(nodes),
+		testAccStringList(users[:1]), testAccStringList(nodes[1:]))
+	withoutOptional := strings.NewReplacer(
+		"  accounts     = [\"root\", \"@SPEC\"]\n", "",
+		"  protocols    = [\"ssh\", \"sftp\"]\n", "",
+		"  date_expired = \"2025-04-01T00:00:00Z\"\n", "",
+	).Replace(config)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             config,
+				ResourceName:       "jumpserver_asset_permission.restricted",
+				ImportState:        true,
+				ImportStateId:      restricted,
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					attrs := testImportedAttributes(states, restricted)
+					want := map[string]string{
+						"users.#":       "2",
+						"user_groups.#": "2",
+						"assets.#":      "2",
+						"nodes.#":       "2",
+						"accounts.#":    "2",
+						"accounts.1":    "@SPEC",
+						"actions.#":     "2",
+						"date_start":    "2024-04-01T00:00:00Z",
+						"date_expired":  "2025-04-01T00:00:00Z",
+					}
+					for key, value := range want {
+						if attrs[key] != value {
+							return fmt.Errorf("%s = %q, want %q", key, attrs[key], value)
+						}
+					}
+					return nil
+				},
+			},
+			{
+				Config:             config,
+				ResourceName:       "jumpserver_asset_permission.defaults",
+				ImportState:        true,
+				ImportStateId:      defaults,
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					// JumpServer's default accounts and dates stay null.
+					attrs := testImportedAttributes(states, defaults)
+					for _, key := range []string{"accounts.#", "date_start", "date_expired"} {
+						if value, ok := attrs[key]; ok {
+							return fmt.Errorf("%s = %q, want unset", key, value)
+						}
+					}
+					return nil
+				},
+			},
+			{
+				// Both imported permissions match the configuration.
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				// Dropping optional attributes of an imported permission converges.
+				Config: withoutOptional,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("jumpserver_asset_permission.restricted", "accounts.#"),
+					resource.TestCheckNoResourceAttr("jumpserver_asset_permission.restricted", "date_expired"),
+					resource.TestCheckResourceAttr("jumpserver_asset_permission.restricted", "protocols.#", "1"),
+					resource.TestCheckResourceAttr("jumpserver_asset_permission.restricted", "protocols.0", "all"),
+				),
+			},
+			{
+				Config:   withoutOptional,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// testPermissionActions returns actions as JumpServer's choice objects.
+func testPermissionActions(actions []string) []interface{} {
+	choices := make([]interface{}, 0, len(actions))
+	for _, action := range actions {
+		choices = append(choices, map[string]interface{}{"value": action, "label": action})
+	}
+	return choices
+}
+
+// testImportedAttributes returns the attributes of the imported instance with
+// the given ID. With ImportStatePersist, states holds every instance in state.
+func testImportedAttributes(states []*terraform.InstanceState, id string) map[string]string {
+	for _, state := range states {
+		if state.ID == id {
+			return state.Attributes
+		}
+	}
+	return nil
+}
+
+func TestAccAssetPermissionResource_removeOptional(t *testing.T) {
+	server := newTestServer(t)
+	user := server.put("/api/v1/users/users/", map[string]interface{}{"username": "alice"})
+	permissionField := func(field string, check func(value interface{}) error) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			id := s.RootModule().Resources["jumpserver_asset_permission.test"].Primary.ID
+			perm, ok := server.object(testAssetPermissionsPath, id)
+			if !ok {
+				return fmt.Errorf("asset permission %s not found on the server", id)
+			}
+			return check(perm[field])
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+resource "jumpserver_asset_permission" "test" {
+  name         = "ops"
+  users        = [%q]
+  accounts     = ["root"]
+  date_start   = "2024-04-01T00:00:00Z"
+  date_expired = "2025-04-01T00:00:00Z"
+}
+`, user),
+			},
+			{
+				// Removed attributes are reset on the server, so the plan after
+				// apply is empty.
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+resource "jumpserver_asset_permission" "test" {
+  name  = "ops"
+  users = [%q]
+}
+`, user),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("jumpserver_asset_permission.test", "accounts.#"),
+					resource.TestCheckNoResourceAttr("jumpserver_asset_permission.test", "date_start"),
+					resource.TestCheckNoResourceAttr("jumpserver_asset_permission.test", "date_expired"),
+					permissionField("accounts", func(value interface{}) error {
+						if fmt.Sprint(value) != "[]" {
+							return fmt.Errorf("accounts = %v, want []", value)
+						}
+						return nil
+					}),
+					permissionField("date_expired", func(value interface{}) error {
+						expired, ok := parsePermissionTime(fmt.Sprint(value))
+						if !ok || expired.Before(time.Now().AddDate(permissionDefaultExpiryYears-1, 0, 0)) {
+							return fmt.Errorf("date_expired = %v, want JumpServer's default", value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
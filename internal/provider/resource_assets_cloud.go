@@ -0,0 +1,345 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+	"terraform-provider-jumpserver/internal/compat"
+)
+
+var (
+	_ resource.Resource                = &assetCloudResource{}
+	_ resource.ResourceWithModifyPlan  = &assetCloudResource{}
+	_ resource.ResourceWithImportState = &assetCloudResource{}
+)
+
+// 资源结构体
+type assetCloudResource struct {
+	client *jumpServerClient
+}
+
+func AssetCloudResource() resource.Resource {
+	return &assetCloudResource{}
+}
+
+type JumpServerCloudResourceModel struct {
+	ID           types.String `tfsdk:"id"`            // 只读
+	Name         types.String `tfsdk:"name"`          // 必填
+	Address      types.String `tfsdk:"address"`       // 必填，URL
+	Platform     types.String `tfsdk:"platform"`      // 必填，须为云平台
+	NodesDisplay types.List   `tfsdk:"nodes_display"` // 必填
+	Protocols    types.List   `tfsdk:"protocols"`     // 必填
+}
+
+// API 字段对应的属性，用于按字段报告校验错误
+var cloudFieldPaths = map[string]path.Path{
+	"name":      path.Root("name"),
+	"address":   path.Root("address"),
+	"platform":  path.Root("platform"),
+	"nodes":     path.Root("nodes_display"),
+	"protocols": path.Root("protocols"),
+}
+
+func (r *assetCloudResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_cloud"
+}
+
+func (r *assetCloudResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *assetCloudResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the cloud asset. Import with `terraform import jumpserver_asset_cloud.example <uuid>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cloud asset",
+			},
+			"address": schema.StringAttribute{
+				Required:    true,
+				Description: "The URL of the cloud endpoint, e.g. the Kubernetes API server `https://k8s.example.com:6443`",
+				Validators: []validator.String{
+					webAddressValidator{},
+				},
+			},
+			"platform": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID or name of the platform of the cloud asset, e.g. `Kubernetes`. The platform must be in the cloud category",
+			},
+			"nodes_display": schema.ListAttribute{
+				Required:    true,
+				Description: "The nodes display of the cloud asset",
+				ElementType: types.StringType,
+			},
+			"protocols": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The protocols of the cloud asset, usually `k8s`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The protocol name. Any case is accepted; it is sent to JumpServer in lowercase",
+						},
+						"port": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Description: "The port of the protocol, between 1 and 65535. Defaults to the conventional port " +
+								"of the protocol, e.g. 443 for `k8s`",
+							Validators: protocolPortValidators,
+							PlanModifiers: []planmodifier.Int64{
+								protocolDefaultPortModifier{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// 计划阶段：校验平台属于云类别，且协议为该类别支持的协议
+func (r *assetCloudResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan JumpServerCloudResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.client.validateProtocolsFromAPI {
+		addUnknownProtocolErrors(&resp.Diagnostics, plan.Protocols)
+	}
+	if plan.Platform.IsUnknown() || plan.Platform.IsNull() {
+		return
+	}
+
+	category, err := r.client.platformCategory(ctx, plan.Platform.ValueString())
+	if err != nil {
+		warnIfRateLimited(&resp.Diagnostics, err, "look up platforms")
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("platform"),
+			"Unable to Verify Platform",
+			fmt.Sprintf("The category of platform %q could not be looked up, so it is not checked to be a cloud platform: %s", plan.Platform.ValueString(), err),
+		)
+		return
+	}
+	if !compat.IsCloudCategory(category) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("platform"),
+			"Unsupported Platform",
+			fmt.Sprintf("Platform %q is in the %q category; cloud assets require a platform in the cloud category.", plan.Platform.ValueString(), category),
+		)
+		return
+	}
+
+	if plan.Protocols.IsUnknown() {
+		return
+	}
+	for i, proto := range plan.Protocols.Elements() {
+		protoObj, ok := proto.(types.Object)
+		if !ok {
+			continue
+		}
+		name, ok := protoObj.Attributes()["name"].(types.String)
+		if !ok || name.IsUnknown() || name.IsNull() {
+			continue
+		}
+		addCategoryProtocolError(&resp.Diagnostics, path.Root("protocols").AtListIndex(i).AtName("name"), category, name.ValueString())
+	}
+}
+
+// 由计划构造创建/更新请求体
+func buildCloudPayload(ctx context.Context, plan JumpServerCloudResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var protocols []ProtocolModel
+	diags.Append(plan.Protocols.ElementsAs(ctx, &protocols, false)...)
+	var nodesDisplay []string
+	diags.Append(plan.NodesDisplay.ElementsAs(ctx, &nodesDisplay, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	asset := map[string]interface{}{
+		"name":          plan.Name.ValueString(),
+		"address":       plan.Address.ValueString(),
+		"platform":      plan.Platform.ValueString(),
+		"nodes_display": nodesDisplay,
+		"protocols":     expandProtocolModels(protocols),
+		"is_active":     true,
+	}
+	return asset, diags
+}
+
+// 创建资源
+func (r *assetCloudResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerCloudResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	asset, diags := buildCloudPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if asset["platform"], diags = resolvePayloadPlatform(ctx, r.client, plan.Platform); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	var result map[string]interface{}
+	if err := r.client.api.Post(ctx, "/api/v1/assets/clouds/", asset, &result); err != nil {
+		addAPIError(&resp.Diagnostics, err, "create cloud assets", "asset management", cloudFieldPaths)
+		return
+	}
+	id := stringField(result, "id")
+	if id == "" {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve cloud asset ID from response")
+		return
+	}
+	plan.ID = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 读取资源
+func (r *assetCloudResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerCloudResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/assets/clouds/%s/", state.ID.ValueString()), nil, &result)
+	// 资产已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read cloud assets", "asset view", nil)
+		return
+	}
+
+	if name, ok := decodeStringField(&resp.Diagnostics, result, "name"); ok {
+		state.Name = types.StringValue(name)
+	}
+	if address, ok := decodeStringField(&resp.Diagnostics, result, "address"); ok {
+		state.Address = types.StringValue(address)
+	}
+	if platform, ok := decodePlatformField(&resp.Diagnostics, result); ok {
+		state.Platform = platformStateValue(ctx, r.client, state.Platform, platform)
+	}
+	serverProtocols, protocolsOk := decodeListField(&resp.Diagnostics, result, "protocols")
+	nodes, nodesOk := decodeListField(&resp.Diagnostics, result, "nodes_display")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if protocolsOk {
+		var prior []ProtocolModel
+		if !state.Protocols.IsNull() && !state.Protocols.IsUnknown() {
+			resp.Diagnostics.Append(state.Protocols.ElementsAs(ctx, &prior, false)...)
+		}
+		protocolsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: protocolAttrTypes}, flattenHostProtocols(prior, serverProtocols))
+		resp.Diagnostics.Append(diags...)
+		state.Protocols = protocolsList
+	}
+	if nodesOk {
+		unique, _ := dedupeNodes(nodes)
+		nodesList, diags := types.ListValueFrom(ctx, types.StringType, unique)
+		resp.Diagnostics.Append(diags...)
+		state.NodesDisplay = nodesList
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// 导入资源：按 UUID 导入，其余属性由 Read 填充
+func (r *assetCloudResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// 更新资源
+func (r *assetCloudResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerCloudResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+
+	asset, diags := buildCloudPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if asset["platform"], diags = resolvePayloadPlatform(ctx, r.client, plan.Platform); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/assets/clouds/%s/", state.ID.ValueString())
+	if err := r.client.api.Patch(ctx, apiPath, asset, nil); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update cloud assets", "asset management", cloudFieldPaths)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 删除资源
+func (r *assetCloudResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerCloudResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/assets/clouds/%s/", state.ID.ValueString())
+	if err := r.client.api.Delete(ctx, apiPath, nil, nil); err != nil && !client.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, err, "delete cloud assets", "asset management", nil)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+var _ resource.Resource = &assetDatabaseResource{}
+var _ resource.ResourceWithImportState = &assetDatabaseResource{}
+var _ resource.ResourceWithModifyPlan = &assetDatabaseResource{}
+
+// 资源结构体
+type assetDatabaseResource struct {
+	client *jumpServerClient
+}
+
+func AssetDatabaseResource() resource.Resource {
+	return &assetDatabaseResource{}
+}
+
+type JumpServerDatabaseResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`          // 必填
+	Address      types.String `tfsdk:"address"`       // 必填
+	DBName       types.String `tfsdk:"db_name"`       // 必填，数据库资产特有
+	Platform     types.String `tfsdk:"platform"`      // 必填
+	NodesDisplay types.List   `tfsdk:"nodes_display"` // 必填
+	Protocols    types.List   `tfsdk:"protocols"`     // 必填
+}
+
+// API 字段对应的属性，用于按字段报告校验错误
+var databaseFieldPaths = map[string]path.Path{
+	"name":      path.Root("name"),
+	"address":   path.Root("address"),
+	"db_name":   path.Root("db_name"),
+	"platform":  path.Root("platform"),
+	"nodes":     path.Root("nodes_display"),
+	"protocols": path.Root("protocols"),
+}
+
+func (r *assetDatabaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_database"
+}
+
+func (r *assetDatabaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *assetDatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the database asset. Import with `terraform import jumpserver_asset_database.example <uuid>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the database asset",
+			},
+			"address": schema.StringAttribute{
+				Required:    true,
+				Description: "The IP address or hostname of the database server",
+				Validators: []validator.String{
+					hostAddressValidator{},
+				},
+			},
+			"db_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the database to connect to",
+			},
+			"platform": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID or name of the platform of the database asset, e.g. `MySQL` or `PostgreSQL`",
+			},
+			"nodes_display": schema.ListAttribute{
+				Required:    true,
+				Description: "The nodes display of the database asset",
+				ElementType: types.StringType,
+			},
+			"protocols": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The protocols of the database asset",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The protocol name. Any case is accepted; it is sent to JumpServer in lowercase",
+						},
+						"port": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The port of the protocol, between 1 and 65535",
+							Validators:  protocolPortValidators,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// 计划阶段：未启用按平台校验时，按内置协议表校验协议名称
+func (r *assetDatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() || r.client.validateProtocolsFromAPI {
+		return
+	}
+
+	var plan JumpServerDatabaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	addUnknownProtocolErrors(&resp.Diagnostics, plan.Protocols)
+}
+
+// 创建资源
+func (r *assetDatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerDatabaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	asset, diags := buildDatabasePayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if asset["platform"], diags = resolvePayloadPlatform(ctx, r.client, plan.Platform); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	var result map[string]interface{}
+	if err := r.client.api.Post(ctx, "/api/v1/assets/databases/", asset, &result); err != nil {
+		addAPIError(&resp.Diagnostics, err, "create database assets", "asset management", databaseFieldPaths)
+		return
+	}
+	id, ok := result["id"].(string)
+	if !ok {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve database asset ID from response")
+		return
+	}
+	plan.ID = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 由计划构造创建/更新请求体
+func buildDatabasePayload(ctx context.Context, plan JumpServerDatabaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var protocols []ProtocolModel
+	diags.Append(plan.Protocols.ElementsAs(ctx, &protocols, false)...)
+	var nodesDisplay []string
+	diags.Append(plan.NodesDisplay.ElementsAs(ctx, &nodesDisplay, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	asset := map[string]interface{}{
+		"name":          plan.Name.ValueString(),
+		"address":       plan.Address.ValueString(),
+		"db_name":       plan.DBName.ValueString(),
+		"platform":      plan.Platform.ValueString(),
+		"nodes_display": nodesDisplay,
+		"protocols":     expandProtocolModels(protocols),
+		"is_active":     true,
+	}
+	return asset, diags
+}
+
+// 读取资源
+func (r *assetDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerDatabaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/assets/databases/%s/", state.ID.ValueString()), nil, &result)
+	// 资产已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read database assets", "asset view", nil)
+		return
+	}
+
+	if name, ok := decodeStringField(&resp.Diagnostics, result, "name"); ok {
+		state.Name = types.StringValue(name)
+	}
+	if address, ok := decodeStringField(&resp.Diagnostics, result, "address"); ok {
+		state.Address = types.StringValue(address)
+	}
+	if dbName, ok := decodeStringField(&resp.Diagnostics, result, "db_name"); ok {
+		state.DBName = types.StringValue(dbName)
+	}
+	if platform, ok := decodePlatformField(&resp.Diagnostics, result); ok {
+		state.Platform = platformStateValue(ctx, r.client, state.Platform, platform)
+	}
+	serverProtocols, protocolsOk := decodeListField(&resp.Diagnostics, result, "protocols")
+	nodes, nodesOk := decodeListField(&resp.Diagnostics, result, "nodes_display")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if protocolsOk {
+		var prior []ProtocolModel
+		if !state.Protocols.IsNull() && !state.Protocols.IsUnknown() {
+			resp.Diagnostics.Append(state.Protocols.ElementsAs(ctx, &prior, false)...)
+		}
+		protocolsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: protocolAttrTypes}, flattenHostProtocols(prior, serverProtocols))
+		resp.Diagnostics.Append(diags...)
+		state.Protocols = protocolsList
+	}
+	if nodesOk {
+		unique, _ := dedupeNodes(nodes)
+		nodesList, diags := types.ListValueFrom(ctx, types.StringType, unique)
+		resp.Diagnostics.Append(diags...)
+		state.NodesDisplay = nodesList
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// 导入资源：按 UUID 导入，其余属性由 Read 填充
+func (r *assetDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// 更新资源
+func (r *assetDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerDatabaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+
+	asset, diags := buildDatabasePayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if asset["platform"], diags = resolvePayloadPlatform(ctx, r.client, plan.Platform); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/assets/databases/%s/", state.ID.ValueString())
+	if err := r.client.api.Patch(ctx, apiPath, asset, nil); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update database assets", "asset management", databaseFieldPaths)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 删除资源
+func (r *assetDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerDatabaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/assets/databases/%s/", state.ID.ValueString())
+	if err := r.client.api.Delete(ctx, apiPath, nil, nil); err != nil && !client.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, err, "delete database assets", "asset management", nil)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
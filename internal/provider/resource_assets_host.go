@@ -1,23 +1,55 @@
 package provider
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-jumpserver/internal/client"
+	"terraform-provider-jumpserver/internal/compat"
 )
 
 var _ resource.Resource = &assetHostResource{}
+var _ resource.ResourceWithModifyPlan = &assetHostResource{}
+var _ resource.ResourceWithConfigValidators = &assetHostResource{}
+var _ resource.ResourceWithImportState = &assetHostResource{}
+
+const (
+	deleteStrategyDelete     = "delete"
+	deleteStrategyDeactivate = "deactivate"
+)
 
 // 资源结构体
 type assetHostResource struct {
-	client *http.Client
+	client *jumpServerClient
 }
 
 func AssetHostResource() resource.Resource {
@@ -28,9 +60,76 @@ type JumpServerHostResourceModel struct {
 	ID           types.String `tfsdk:"id"`
 	Name         types.String `tfsdk:"name"`          // 必填
 	IP           types.String `tfsdk:"ip"`            // 必填
-	Platform     types.String `tfsdk:"platform"`      // 必填
+	Platform     types.String `tfsdk:"platform"`      // 可选，默认使用 provider 的 default_platform
 	NodesDisplay types.List   `tfsdk:"nodes_display"` // 必填
-	Protocols    types.List   `tfsdk:"protocols"`     // 必填
+	Protocols    types.List   `tfsdk:"protocols"`     // 与 protocols_simple 二选一
+
+	ProtocolsSimple types.Map    `tfsdk:"protocols_simple"` // 与 protocols 二选一
+	Region          types.String `tfsdk:"region"`           // 可选，写入 custom_info
+	Comment         types.String `tfsdk:"comment"`          // 可选
+	IsActive        types.Bool   `tfsdk:"is_active"`        // 可选，默认 true
+	OrgID           types.String `tfsdk:"org_id"`           // 可选，覆盖 provider 的 org_id
+	Accounts        types.List   `tfsdk:"accounts"`         // 可选，随资产一起创建的账号，修改后重建
+
+	DeleteStrategy types.String `tfsdk:"delete_strategy"` // 可选，默认 delete
+
+	TerminateSessionsOnDestroy types.Bool   `tfsdk:"terminate_sessions_on_destroy"` // 可选，默认 false
+	Labels                     types.List   `tfsdk:"labels"`                        // 可选，标签 ID
+	LabelsCount                types.Int64  `tfsdk:"labels_count"`                  // 只读
+	DateCreated                types.String `tfsdk:"date_created"`                  // 只读
+	CreatedBy                  types.String `tfsdk:"created_by"`                    // 只读
+
+	ManageProtocolsExclusively types.Bool `tfsdk:"manage_protocols_exclusively"` // 可选，默认 true
+
+	Raw types.String `tfsdk:"raw"` // 内部使用：未建模字段的原始 JSON
+
+	ProtocolsConnectivity types.Map `tfsdk:"protocols_connectivity"` // 只读
+
+	VerifyOnAddressChange types.Bool     `tfsdk:"verify_on_address_change"` // 可选，默认 false
+	Connectivity          types.String   `tfsdk:"connectivity"`             // 只读
+	PushAccounts          types.Bool     `tfsdk:"push_accounts"`            // 可选，默认 false
+	VerifyAfterPush       types.Bool     `tfsdk:"verify_after_push"`        // 可选，默认 false
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// API 字段对应的属性，用于按字段报告校验错误
+var hostFieldPaths = map[string]path.Path{
+	"name":      path.Root("name"),
+	"address":   path.Root("ip"),
+	"platform":  path.Root("platform"),
+	"nodes":     path.Root("nodes_display"),
+	"protocols": path.Root("protocols"),
+	"labels":    path.Root("labels"),
+	"comment":   path.Root("comment"),
+	"is_active": path.Root("is_active"),
+	"accounts":  path.Root("accounts"),
+}
+
+// 由资源模型管理的 API 字段，其余字段保存在 raw 中
+var hostModeledFields = map[string]bool{
+	"id":            true,
+	"name":          true,
+	"address":       true,
+	"platform":      true,
+	"nodes":         true,
+	"nodes_display": true,
+	"protocols":     true,
+	"is_active":     true,
+	"comment":       true,
+	"labels":        true,
+	"date_created":  true,
+	"created_by":    true,
+	"accounts":      true,
+	"connectivity":  true,
+}
+
+// 未建模字段中可写的字段，更新时随请求发回以免被清空；
+// 其余未建模字段（如 date_updated、connectivity、gathered_info）由服务端维护，不能发回
+var hostPreservedFields = map[string]bool{
+	"domain":      true,
+	"zone":        true,
+	"gateway":     true,
+	"custom_info": true,
 }
 
 // 协议数据模型
@@ -39,6 +138,32 @@ type ProtocolModel struct {
 	Port types.Int64  `tfsdk:"port"` // 可选
 }
 
+// 随资产创建的账号
+type HostAccountModel struct {
+	Name       types.String `tfsdk:"name"`        // 必填
+	Username   types.String `tfsdk:"username"`    // 必填
+	SecretType types.String `tfsdk:"secret_type"` // 可选，默认 password
+	Privileged types.Bool   `tfsdk:"privileged"`  // 可选，默认 false
+	Secret     types.String `tfsdk:"secret"`      // 可选，敏感，不从 API 读取
+	Verified   types.Bool   `tfsdk:"verified"`    // 只读，verify_after_push 时由验证结果填充
+}
+
+// 账号对象的属性类型，与 schema 中的嵌套对象一致
+var hostAccountAttrTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"username":    types.StringType,
+	"secret_type": types.StringType,
+	"privileged":  types.BoolType,
+	"secret":      types.StringType,
+	"verified":    types.BoolType,
+}
+
+// 协议对象的属性类型，与 schema 中的嵌套对象一致
+var protocolAttrTypes = map[string]attr.Type{
+	"name": types.StringType,
+	"port": types.Int64Type,
+}
+
 func (r *assetHostResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_asset_host"
 }
@@ -48,11 +173,11 @@ func (r *assetHostResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*http.Client)
+	client, ok := req.ProviderData.(*jumpServerClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -60,12 +185,13 @@ func (r *assetHostResource) Configure(ctx context.Context, req resource.Configur
 	r.client = client
 }
 
-func (r *assetHostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *assetHostResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Computed:    true,
-				Description: "The ID of the asset host",
+				Computed: true,
+				Description: "The ID of the asset host. Existing hosts are imported by this API UUID, e.g. `terraform import jumpserver_asset_host.web <uuid>`, " +
+					"or by `<org_id>:<uuid>` to import a host from another organization",
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -73,349 +199,1695 @@ func (r *assetHostResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"ip": schema.StringAttribute{
 				Required:    true,
-				Description: "The IP address of the asset host",
+				Description: "The IP address or hostname of the asset host",
+				Validators: []validator.String{
+					hostAddressValidator{},
+				},
 			},
 			"platform": schema.StringAttribute{
-				Required:    true,
-				Description: "The platform of the asset host",
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID or name of the platform of the asset host. Names are resolved to IDs through the platforms API. Defaults to the provider's `default_platform`",
 			},
 			"nodes_display": schema.ListAttribute{
-				Required:    true,
-				Description: "The nodes display of the asset host",
+				Required: true,
+				Description: "The full paths of the nodes the asset host belongs to, e.g. `/Default/Web`. On update the host " +
+					"is added to new nodes before it is removed from old ones, so it always stays in at least one node",
 				ElementType: types.StringType,
 			},
 			"protocols": schema.ListNestedAttribute{
-				Required: true,
+				Optional:    true,
+				Description: "The protocols of the asset host. Conflicts with `protocols_simple`",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
-							Required: true,
+							Required:    true,
+							Description: "The protocol name. Any case is accepted; it is sent to JumpServer in lowercase",
 						},
 						"port": schema.Int64Attribute{
 							Optional: true,
+							Computed: true,
+							Description: "The port of the protocol, between 1 and 65535. Defaults to the conventional port " +
+								"of the protocol, e.g. 22 for `ssh` or 3389 for `rdp`",
+							Validators: protocolPortValidators,
+							PlanModifiers: []planmodifier.Int64{
+								protocolDefaultPortModifier{},
+							},
+						},
+					},
+				},
+			},
+			"protocols_simple": schema.MapAttribute{
+				Optional:    true,
+				Description: "The protocols of the asset host as a map of protocol name to port, e.g. `{ ssh = 22 }`. Conflicts with `protocols`",
+				ElementType: types.Int64Type,
+				Validators: []validator.Map{
+					mapvalidator.ValueInt64sAre(protocolPortValidators...),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "The cloud region of the asset host, stored in the asset's custom info. Other custom info fields are kept, and removing region clears it. Only meaningful for cloud platforms; other platforms get a warning",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "A free-form comment on the asset host",
+			},
+			"is_active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the asset host is active. Inactive hosts stay in JumpServer but cannot be connected to",
+			},
+			"org_id": schema.StringAttribute{
+				Optional: true,
+				Description: "The ID of the organization the asset host is managed in. Overrides the provider's `org_id` " +
+					"for this resource; changing it replaces the asset host",
+				Validators: uuidValidators,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"accounts": schema.ListNestedAttribute{
+				Optional: true,
+				Description: "Accounts created on the asset host together with it. Accounts are only sent when the host is created, " +
+					"so changing them replaces the asset host; manage accounts added later with `jumpserver_account`. " +
+					"Accounts created outside Terraform are ignored",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the account, unique on the asset host",
+						},
+						"username": schema.StringAttribute{
+							Required:    true,
+							Description: "The username of the account",
+						},
+						"secret_type": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("password"),
+							Description: "The kind of secret: `password` or `ssh_key`",
+							Validators: []validator.String{
+								stringvalidator.OneOf("password", "ssh_key"),
+							},
+						},
+						"privileged": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+							Description: "Whether the account is privileged",
+						},
+						// The plugin framework in use has no write-only attributes, so the
+						// secret is kept in state but marked sensitive and never read back.
+						"secret": schema.StringAttribute{
+							Optional:  true,
+							Sensitive: true,
+							Description: "The password or SSH private key of the account. " +
+								"The secret is never read back from JumpServer, so changes made outside Terraform are not detected",
+						},
+						"verified": schema.BoolAttribute{
+							Computed: true,
+							Description: "Whether JumpServer could log in to the asset host with the account when it was created. " +
+								"Only set when `verify_after_push` is true",
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
 						},
 					},
 				},
 			},
+			"manage_protocols_exclusively": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				Description: "When true (the default), `protocols` is the complete protocol set of the asset host and " +
+					"protocols added outside Terraform are removed on update. When false, protocols not declared in " +
+					"the configuration are kept on update and are not reported as drift.",
+			},
+			"delete_strategy": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(deleteStrategyDelete),
+				Description: "How the asset host is removed on destroy: `delete` removes it from JumpServer, " +
+					"`deactivate` only sets `is_active` to false. With `deactivate` the asset remains in JumpServer " +
+					"after it is removed from Terraform state and must be cleaned up manually.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(deleteStrategyDelete, deleteStrategyDeactivate),
+				},
+			},
+			"terminate_sessions_on_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "Terminate the active sessions on the asset host before it is destroyed. This is disruptive: " +
+					"users connected to the host are disconnected without warning",
+			},
+			"labels": schema.ListAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "The IDs of the labels attached to the asset host. When set, this is the complete label set: " +
+					"labels removed from the list are detached on update. Set to `[]` to detach every label; " +
+					"when not set, the labels attached in JumpServer are left unchanged",
+				ElementType: types.StringType,
+				Validators:  uuidListValidators,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of labels attached to the asset host",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"protocols_connectivity": schema.MapAttribute{
+				Computed:    true,
+				Description: "The connectivity status reported by JumpServer for each protocol, keyed by protocol name. Null when the API does not report per-protocol status",
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verify_on_address_change": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "Run a connectivity test on the asset host after an update changes its address, and wait for it " +
+					"within the update timeout. The result is reported in `connectivity`",
+			},
+			"connectivity": schema.StringAttribute{
+				Computed:    true,
+				Description: "The connectivity status of the asset host as last reported by JumpServer, e.g. `ok` or `err`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"push_accounts": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "Push `accounts` to the asset host after it is created, and wait for the push within the create " +
+					"timeout. Accounts that fail to push are reported as warnings naming the account and the reason",
+			},
+			"verify_after_push": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "Verify the secrets of `accounts` against the asset host after it is created, and wait for the " +
+					"verification within the create timeout. The results are reported in each account's `verified`",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+			// 服务端在每次更新时都可能修改未建模字段（如 date_updated），更新时不沿用状态中的值
+			"raw": schema.StringAttribute{
+				Computed:    true,
+				Description: "Internal. JSON of the API fields this resource does not model; the writable ones are preserved on update",
+			},
+			"date_created": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the asset host was created",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed:    true,
+				Description: "The user who created the asset host",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
 
-// 创建资源
-func (r *assetHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan JumpServerHostResourceModel
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+// 配置校验：protocols 与 protocols_simple 必须且只能设置一个，且协议不能为空或重复
+func (r *assetHostResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("protocols"),
+			path.MatchRoot("protocols_simple"),
+		),
+		hostProtocolsValidator{},
 	}
+}
 
-	// 解析用户定义的协议
-	var protocols []map[string]interface{}
-	for _, proto := range plan.Protocols.Elements() {
-		protoObj, ok := proto.(types.Object)
-		if !ok {
-			resp.Diagnostics.AddError("Type Assertion Error", "Failed to assert protocol as types.Object")
-			return
-		}
-
-		nameAttr, nameOk := protoObj.Attributes()["name"]
-		portAttr, portOk := protoObj.Attributes()["port"]
+var _ resource.ConfigValidator = hostProtocolsValidator{}
 
-		if !nameOk {
-			resp.Diagnostics.AddError("Missing Attribute", "Protocol name is required")
-			return
-		}
+// hostProtocolsValidator 要求至少配置一个协议，且协议名称（不区分大小写）不重复
+type hostProtocolsValidator struct{}
 
-		protocol := map[string]interface{}{
-			"name": nameAttr.(types.String).ValueString(),
-		}
+func (v hostProtocolsValidator) Description(_ context.Context) string {
+	return "at least one protocol must be configured and protocol names must be unique"
+}
 
-		if portOk {
-			protocol["port"] = portAttr.(types.Int64).ValueInt64()
-		}
+func (v hostProtocolsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
 
-		protocols = append(protocols, protocol)
+func (v hostProtocolsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var protocols []ProtocolModel
+	var protocolsList types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("protocols"), &protocolsList)...)
+	var protocolsSimple types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("protocols_simple"), &protocolsSimple)...)
+	if resp.Diagnostics.HasError() || protocolsList.IsUnknown() || protocolsSimple.IsUnknown() {
+		return
 	}
 
-	var nodesDisplay []string
-	if !plan.NodesDisplay.IsNull() {
-		var nodes []types.String
-		diags := plan.NodesDisplay.ElementsAs(context.Background(), &nodes, false)
-		if diags.HasError() {
-			resp.Diagnostics.AddError("Data Conversion Error", "Failed to convert nodes_display to []string")
+	// 二选一由 ExactlyOneOf 校验，这里只检查已设置的一项
+	switch {
+	case !protocolsList.IsNull():
+		if len(protocolsList.Elements()) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("protocols"), "Missing Protocol", "At least one protocol must be configured.")
 			return
 		}
-		for _, node := range nodes {
-			nodesDisplay = append(nodesDisplay, node.ValueString())
+		for _, element := range protocolsList.Elements() {
+			if element.IsUnknown() {
+				return
+			}
+		}
+		resp.Diagnostics.Append(protocolsList.ElementsAs(ctx, &protocols, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		seen := map[string]bool{}
+		for i, proto := range protocols {
+			if proto.Name.IsUnknown() || proto.Name.IsNull() {
+				continue
+			}
+			name := strings.ToLower(proto.Name.ValueString())
+			if seen[name] {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("protocols").AtListIndex(i).AtName("name"),
+					"Duplicate Protocol",
+					fmt.Sprintf("Protocol %q is configured more than once.", name),
+				)
+			}
+			seen[name] = true
+		}
+	case !protocolsSimple.IsNull():
+		if len(protocolsSimple.Elements()) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("protocols_simple"), "Missing Protocol", "At least one protocol must be configured.")
+			return
+		}
+		seen := map[string]string{}
+		for name := range protocolsSimple.Elements() {
+			canonical := strings.ToLower(name)
+			if other, ok := seen[canonical]; ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("protocols_simple").AtMapKey(name),
+					"Duplicate Protocol",
+					fmt.Sprintf("Protocol %q is configured more than once (also as %q).", canonical, other),
+				)
+			}
+			seen[canonical] = name
 		}
 	}
+}
 
-	// 构造请求体
-	asset := map[string]interface{}{
-		"name":          plan.Name.ValueString(),     // 使用 "name"
-		"address":       plan.IP.ValueString(),       // 使用 "address"
-		"platform":      plan.Platform.ValueString(), //1,                       // 使用整数形式的平台 ID
-		"nodes_display": nodesDisplay,                // 使用 "nodes_display"
-		"protocols":     protocols,
-		"is_active":     true, // 默认激活
-	}
-
-	apiPath := "/api/v1/assets/hosts/" // 确保路径包含 API 版本
-	fullURL := fmt.Sprintf("%s%s", r.client.Transport.(*authTransport).BaseURL, apiPath)
-
-	jsonValue, err := json.Marshal(asset) // 直接传递 asset，不需要包装在 "data" 字段中
-	if err != nil {
-		resp.Diagnostics.AddError("JSON Marshal Error", fmt.Sprintf("Error marshaling request body: %v", err))
-		return
-	}
-
-	reqBody := bytes.NewBuffer(jsonValue)
-
-	// 打印调试信息
-	fmt.Println("Full URL:", fullURL)
-	fmt.Println("Request Body:", string(jsonValue))
-
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, fullURL, reqBody) // 确保使用 POST 方法
-	if err != nil {
-		resp.Diagnostics.AddError("HTTP Request Error", fmt.Sprintf("Error creating asset: %v", err))
+// 计划阶段：填充默认平台，并校验协议与平台、地域与平台的匹配关系
+func (r *assetHostResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
 		return
 	}
 
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("Authorization", "Bearer "+r.client.Transport.(*authTransport).Token)
-
-	client := &http.Client{}
-	respBody, err := client.Do(httpReq)
-	if err != nil {
-		resp.Diagnostics.AddError("HTTP Request Error", fmt.Sprintf("Error creating asset: %v", err))
+	var plan JumpServerHostResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	defer respBody.Body.Close()
-
-	// 打印响应状态码和响应体
-	body, _ := io.ReadAll(respBody.Body)
-	fmt.Println("Response Status:", respBody.Status)
-	fmt.Println("Response Body:", string(body))
 
-	if respBody.StatusCode != http.StatusCreated {
-		resp.Diagnostics.AddError("HTTP Status Error", fmt.Sprintf("Error creating asset: %s, Response: %s", respBody.Status, string(body)))
+	// 未配置 platform 时使用 provider 的 default_platform
+	var configPlatform types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("platform"), &configPlatform)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	// 解析响应体
-	var result map[string]interface{}
-	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&result); err != nil {
-		resp.Diagnostics.AddError("Response Decode Error", fmt.Sprintf("Error decoding response: %v", err))
-		return
+	if configPlatform.IsNull() {
+		if r.client.defaultPlatform == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("platform"),
+				"Missing Platform",
+				"The asset host has no platform. Set platform on the resource or default_platform on the provider.",
+			)
+			return
+		}
+		plan.Platform = types.StringValue(r.client.defaultPlatform)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("platform"), plan.Platform)...)
 	}
 
-	// 提取资产的 ID
-	if id, ok := result["id"].(string); ok {
-		plan.ID = types.StringValue(id)
+	// 标签数量随计划中的标签变化，避免沿用状态中的旧值
+	if !plan.Labels.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("labels_count"), int64(len(plan.Labels.Elements())))...)
 	} else {
-		resp.Diagnostics.AddError("API Error", "Unable to retrieve asset ID from response")
-		return
-	}
-
-	// 更新 Terraform 状态
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-}
-
-// 读取资源
-func (r *assetHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state JumpServerHostResourceModel
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	id := state.ID.ValueString()
-	apiPath := fmt.Sprintf("/api/v1/assets/hosts/%s/", id)
-	fullURL := fmt.Sprintf("%s%s", r.client.Transport.(*authTransport).BaseURL, apiPath)
-
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("HTTP Request Error", fmt.Sprintf("Unable to create request: %s", err))
-		return
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("labels_count"), types.Int64Unknown())...)
 	}
-	httpReq.Header.Set("accept", "application/json")
-	httpReq.Header.Set("Authorization", "Bearer "+r.client.Transport.(*authTransport).Token)
 
-	client := &http.Client{}
-	httpResp, err := client.Do(httpReq)
-	if err != nil {
-		resp.Diagnostics.AddError("HTTP Request Error", fmt.Sprintf("Unable to send request: %s", err))
-		return
+	if !req.State.Raw.IsNull() {
+		var state JumpServerHostResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// 修改地址并启用连通性检查时，连通性状态在更新后才能得知
+		if plan.VerifyOnAddressChange.ValueBool() && !plan.IP.Equal(state.IP) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("connectivity"), types.StringUnknown())...)
+		}
+		// 协议变化后各协议的连通性由服务端重新给出
+		if !plan.Protocols.Equal(state.Protocols) || !plan.ProtocolsSimple.Equal(state.ProtocolsSimple) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("protocols_connectivity"), types.MapUnknown(types.StringType))...)
+		}
 	}
-	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(httpResp.Body)
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unexpected status code: %s, Response: %s", httpResp.Status, string(body)))
-		return
+	// 未启用按平台校验时，按内置协议表校验协议名称
+	if !r.client.validateProtocolsFromAPI {
+		addUnknownProtocolErrors(&resp.Diagnostics, plan.Protocols)
+		if !plan.ProtocolsSimple.IsUnknown() {
+			for name := range plan.ProtocolsSimple.Elements() {
+				addUnknownProtocolError(&resp.Diagnostics, path.Root("protocols_simple").AtMapKey(name), name)
+			}
+		}
 	}
 
-	// 适配 API 返回的对象
-	var result map[string]interface{}
-	if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-		resp.Diagnostics.AddError("JSON Decode Error", fmt.Sprintf("Unable to decode response: %s", err))
+	if plan.Platform.IsUnknown() || plan.Platform.IsNull() {
 		return
 	}
 
-	// 更新状态
-	if name, ok := result["name"].(string); ok {
-		state.Name = types.StringValue(name)
-	}
-	if ip, ok := result["ip"].(string); ok {
-		state.IP = types.StringValue(ip)
+	if r.client.validateProtocolsFromAPI {
+		r.validatePlatformProtocols(ctx, plan, resp)
+	} else {
+		r.validateCategoryProtocols(ctx, plan, resp)
 	}
-	if platform, ok := result["platform"].(string); ok {
-		state.Platform = types.StringValue(platform)
+	if !plan.Region.IsNull() && !plan.Region.IsUnknown() {
+		r.checkRegionPlatform(ctx, plan, resp)
 	}
-
-	diags = resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
 }
 
-/*
-func (r *assetHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state JumpServerHostResourceModel
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+// 按平台允许的协议校验协议名称
+func (r *assetHostResource) validatePlatformProtocols(ctx context.Context, plan JumpServerHostResourceModel, resp *resource.ModifyPlanResponse) {
+	if plan.Protocols.IsUnknown() || plan.ProtocolsSimple.IsUnknown() {
 		return
 	}
 
-	// 发送请求
-	id := state.ID.ValueString()
-
-	apiPath := "/api/v1/assets/hosts/suggestions/"
-	queryParams := url.Values{}
+	allowed := map[string]bool{}
+	for _, name := range r.client.allowedProtocols(ctx, plan.Platform.ValueString()) {
+		allowed[strings.ToLower(name)] = true
+	}
 
-	queryParams.Add("id", id)
+	for i, proto := range plan.Protocols.Elements() {
+		protoObj, ok := proto.(types.Object)
+		if !ok {
+			continue
+		}
+		name, ok := protoObj.Attributes()["name"].(types.String)
+		if !ok || name.IsUnknown() || name.IsNull() {
+			continue
+		}
+		if canonical := strings.ToLower(name.ValueString()); !allowed[canonical] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("protocols").AtListIndex(i).AtName("name"),
+				"Unsupported Protocol",
+				fmt.Sprintf("Protocol %q is not allowed by platform %q.", canonical, plan.Platform.ValueString()),
+			)
+		}
+	}
 
-	fullURL := fmt.Sprintf("%s%s?%s", r.client.Transport.(*authTransport).BaseURL, apiPath, queryParams.Encode())
+	for name := range plan.ProtocolsSimple.Elements() {
+		if canonical := strings.ToLower(name); !allowed[canonical] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("protocols_simple").AtMapKey(name),
+				"Unsupported Protocol",
+				fmt.Sprintf("Protocol %q is not allowed by platform %q.", canonical, plan.Platform.ValueString()),
+			)
+		}
+	}
+}
 
-	httpReq, err := http.NewRequest("GET", fullURL, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("HTTP Error", fmt.Sprintf("Unable to create request: %s", err))
+// 未启用按平台校验时，按内置协议表校验平台类别是否支持各协议；
+// 平台类别查询失败或不在协议表中时不校验
+func (r *assetHostResource) validateCategoryProtocols(ctx context.Context, plan JumpServerHostResourceModel, resp *resource.ModifyPlanResponse) {
+	if plan.Protocols.IsUnknown() || plan.ProtocolsSimple.IsUnknown() {
 		return
 	}
-
-	httpResp, err := r.client.Do(httpReq)
+	category, err := r.client.platformCategory(ctx, plan.Platform.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("HTTP Error", fmt.Sprintf("Unable to send request: %s", err))
+		warnIfRateLimited(&resp.Diagnostics, err, "look up platforms")
+		tflog.Debug(ctx, "Unable to look up the platform category, protocols are not checked against it", map[string]interface{}{"error": err.Error()})
 		return
 	}
-	defer httpResp.Body.Close()
-
-	// 检查响应状态码
-	if httpResp.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unexpected status code: %s", httpResp.Status))
+	if !compat.KnownCategory(category) {
 		return
 	}
 
-	var response struct {
-		Count    int                      `json:"count"`
-		Next     string                   `json:"next"`
-		Previous string                   `json:"previous"`
-		Results  []map[string]interface{} `json:"results"`
+	for i, proto := range plan.Protocols.Elements() {
+		protoObj, ok := proto.(types.Object)
+		if !ok {
+			continue
+		}
+		name, ok := protoObj.Attributes()["name"].(types.String)
+		if !ok || name.IsUnknown() || name.IsNull() {
+			continue
+		}
+		addCategoryProtocolError(&resp.Diagnostics, path.Root("protocols").AtListIndex(i).AtName("name"), category, name.ValueString())
 	}
-	if err := json.NewDecoder(httpResp.Body).Decode(&response); err != nil {
-		resp.Diagnostics.AddError("JSON Decode Error", fmt.Sprintf("Unable to decode response: %s", err))
-		return
+	for name := range plan.ProtocolsSimple.Elements() {
+		addCategoryProtocolError(&resp.Diagnostics, path.Root("protocols_simple").AtMapKey(name), category, name)
 	}
+}
 
-	// 检查 results 是否为空
-	if len(response.Results) == 0 {
-		resp.Diagnostics.AddError("API Error", "No results found for the given ID")
+// region 仅对云相关平台有意义，其他平台给出警告
+func (r *assetHostResource) checkRegionPlatform(ctx context.Context, plan JumpServerHostResourceModel, resp *resource.ModifyPlanResponse) {
+	category, err := r.client.platformCategory(ctx, plan.Platform.ValueString())
+	if err != nil {
+		warnIfRateLimited(&resp.Diagnostics, err, "look up platforms")
 		return
 	}
-
-	result := response.Results[0]
-	if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-		resp.Diagnostics.AddError("JSON Decode Error", fmt.Sprintf("Unable to decode response: %s", err))
-		return
+	if !compat.IsCloudCategory(category) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("region"),
+			"Region Not Meaningful For Platform",
+			fmt.Sprintf("Platform %q is in the %q category; region is only meaningful for cloud platforms and will be stored as custom metadata only.",
+				plan.Platform.ValueString(), category),
+		)
 	}
+}
 
-	state.Name = types.StringValue(result["name"].(string))
-	state.IP = types.StringValue(result["ip"].(string))
-	state.Platform = types.StringValue(result["platform"].(string))
-	protocols := result["protocols"].([]interface{})
-	protocolsList, diags := types.ListValueFrom(ctx, types.StringType, protocols)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	state.Protocols = protocolsList
-	nodesDisplay := result["nodes_display"].([]interface{})
-	nodesDisplayList, diags := types.ListValueFrom(ctx, types.StringType, nodesDisplay)
+// 创建资源
+func (r *assetHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerHostResourceModel
+	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	state.NodesDisplay = nodesDisplayList
-
-	// 保存状态
-	diags = resp.State.Set(ctx, state)
-	resp.Diagnostics.Append(diags...)
-}
-*/
-// 更新资源
-func (r *assetHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-
-}
 
-// 删除资源
-func (r *assetHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state JumpServerHostResourceModel
-	diags := req.State.Get(ctx, &state)
+	asset, diags := buildHostPayload(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	// 获取资源 ID
-	id := state.ID.ValueString()
-	if id == "" {
-		resp.Diagnostics.AddError("Missing ID", "Resource ID is required for deletion")
+	if asset["platform"], diags = resolvePayloadPlatform(ctx, r.client, plan.Platform); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
 		return
 	}
-
-	// 构造 API URL
-	apiPath := fmt.Sprintf("/api/v1/assets/hosts/%s/", id)
-	fullURL := fmt.Sprintf("%s%s", r.client.Transport.(*authTransport).BaseURL, apiPath)
-
-	// 创建 HTTP DELETE 请求
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodDelete, fullURL, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("HTTP Request Error", fmt.Sprintf("Unable to create request: %s", err))
-		return
+	setHostRegion(asset, plan.Region)
+	// 账号只在创建时随资产发送
+	if !plan.Accounts.IsNull() && !plan.Accounts.IsUnknown() {
+		var accounts []HostAccountModel
+		resp.Diagnostics.Append(plan.Accounts.ElementsAs(ctx, &accounts, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		asset["accounts"] = expandHostAccounts(accounts)
 	}
+	tflog.Debug(ctx, "Creating asset host", map[string]interface{}{
+		"name":     plan.Name.ValueString(),
+		"address":  plan.IP.ValueString(),
+		"platform": asset["platform"],
+	})
 
-	// 设置请求头
-	httpReq.Header.Set("accept", "application/json")
-	httpReq.Header.Set("Authorization", "Bearer "+r.client.Transport.(*authTransport).Token)
-
-	// 发送请求
-	client := &http.Client{}
-	httpResp, err := client.Do(httpReq)
-	if err != nil {
-		resp.Diagnostics.AddError("HTTP Request Error", fmt.Sprintf("Unable to send request: %s", err))
+	// 整个创建（包括账号验证）受创建超时限制
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultHostCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	defer httpResp.Body.Close()
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
-	// 检查响应状态码
-	if httpResp.StatusCode != http.StatusNoContent && httpResp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(httpResp.Body)
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unexpected status code: %s, Response: %s", httpResp.Status, string(body)))
+	// 创建成功时 v3 及以上版本返回 201，部分早期版本返回 200。状态码与检测到的版本不符时，
+	// 响应可能已被代理改写；代理也可能去掉响应体。这两种情况都按名称和地址查询刚创建的资产
+	var result map[string]interface{}
+	org := orgOption(plan.OrgID)
+	status, err := r.client.api.DoStatus(ctx, http.MethodPost, "/api/v1/assets/hosts/", asset, &result, org)
+	var decodeErr *client.DecodeError
+	if err != nil && !errors.As(err, &decodeErr) {
+		addAPIError(&resp.Diagnostics, err, "create asset hosts", "asset management", hostFieldPaths)
+		return
+	}
+	if result == nil || !r.client.isCreateStatus(status) {
+		tflog.Debug(ctx, "Create response has no usable JSON body, looking up the created asset", map[string]interface{}{
+			"status":  status,
+			"version": r.client.version(),
+			"error":   fmt.Sprint(err),
+		})
+		result, err = r.findCreatedHost(ctx, plan.Name.ValueString(), plan.IP.ValueString(), org)
+		if err != nil {
+			resp.Diagnostics.AddError("Response Decode Error",
+				fmt.Sprintf("The asset was created but the response contained no asset, and looking it up by name and address failed: %v", err))
+			return
+		}
+	}
+
+	// 提取资产的 ID
+	if id, ok := result["id"].(string); ok {
+		plan.ID = types.StringValue(id)
+	} else {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve asset ID from response")
+		return
+	}
+	tflog.Debug(ctx, "Created asset host", map[string]interface{}{"id": plan.ID.ValueString()})
+	plan.Labels, plan.LabelsCount = flattenAssetLabels(plan.Labels, result["labels"])
+	plan.DateCreated, plan.CreatedBy = flattenAssetProvenance(result)
+	plan.Raw = flattenUnmodeledFields(result)
+	plan.ProtocolsConnectivity = flattenProtocolConnectivity(result)
+	plan.Connectivity = flattenAssetConnectivity(result)
+	resp.Diagnostics.Append(r.pushAccounts(ctx, plan, org)...)
+	resp.Diagnostics.Append(r.setAccountsVerified(ctx, &plan, org)...)
+
+	// 更新 Terraform 状态
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// 由计划构造创建/更新请求体，Create 与 Update 共用
+func buildHostPayload(ctx context.Context, plan JumpServerHostResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// 解析用户定义的协议
+	var protocols []map[string]interface{}
+	for _, proto := range plan.Protocols.Elements() {
+		protoObj, ok := proto.(types.Object)
+		if !ok {
+			diags.AddError("Type Assertion Error", "Failed to assert protocol as types.Object")
+			return nil, diags
+		}
+
+		nameAttr, nameOk := protoObj.Attributes()["name"]
+		portAttr, portOk := protoObj.Attributes()["port"]
+
+		if !nameOk {
+			diags.AddError("Missing Attribute", "Protocol name is required")
+			return nil, diags
+		}
+
+		name, ok := nameAttr.(types.String)
+		if !ok {
+			diags.AddError("Type Assertion Error", "Failed to assert protocol name as types.String")
+			return nil, diags
+		}
+		protocol := map[string]interface{}{
+			"name": strings.ToLower(name.ValueString()), // JumpServer 只接受小写协议名
+		}
+
+		if portOk {
+			port, ok := portAttr.(types.Int64)
+			if !ok {
+				diags.AddError("Type Assertion Error", "Failed to assert protocol port as types.Int64")
+				return nil, diags
+			}
+			if !port.IsNull() {
+				protocol["port"] = port.ValueInt64()
+			}
+		}
+
+		protocols = append(protocols, protocol)
+	}
+
+	// 简写形式：将 protocols_simple 展开为完整的协议对象
+	if !plan.ProtocolsSimple.IsNull() {
+		protocols = expandSimpleProtocols(plan.ProtocolsSimple)
+	}
+
+	var nodesDisplay []string
+	if !plan.NodesDisplay.IsNull() {
+		var nodes []types.String
+		if plan.NodesDisplay.ElementsAs(ctx, &nodes, false).HasError() {
+			diags.AddError("Data Conversion Error", "Failed to convert nodes_display to []string")
+			return nil, diags
+		}
+		for _, node := range nodes {
+			nodesDisplay = append(nodesDisplay, node.ValueString())
+		}
+	}
+
+	// 构造请求体
+	asset := map[string]interface{}{
+		"name":          plan.Name.ValueString(),     // 使用 "name"
+		"address":       plan.IP.ValueString(),       // 使用 "address"
+		"platform":      plan.Platform.ValueString(), // 名称由调用方解析为 ID
+		"nodes_display": nodesDisplay,                // 使用 "nodes_display"
+		"protocols":     protocols,
+		"is_active":     plan.IsActive.ValueBool(),
+		"comment":       plan.Comment.ValueString(), // 未设置时清空备注
+	}
+	// 设置标签时整体替换，列表中移除的标签会被解绑
+	if !plan.Labels.IsNull() && !plan.Labels.IsUnknown() {
+		labels := []string{}
+		diags.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+		asset["labels"] = labels
+	}
+	return asset, diags
+}
+
+// 在请求体的 custom_info 上设置或删除 region，保留其中其他自定义字段。
+// 更新时请求体中的 custom_info 来自服务端（见 mergeUnmodeledFields）
+func setHostRegion(asset map[string]interface{}, region types.String) {
+	customInfo := map[string]interface{}{}
+	if existing, ok := asset["custom_info"].(map[string]interface{}); ok {
+		for key, value := range existing {
+			customInfo[key] = value
+		}
+	}
+	if region.IsNull() || region.IsUnknown() {
+		if _, ok := customInfo["region"]; !ok {
+			return
+		}
+		delete(customInfo, "region")
+	} else {
+		customInfo["region"] = region.ValueString()
+	}
+	asset["custom_info"] = customInfo
+}
+
+// 创建后查询资产的重试次数与间隔，用于应对最终一致性
+const (
+	createLookupAttempts = 5
+	createLookupInterval = 2 * time.Second
+)
+
+// 按名称和地址查询刚创建的资产
+func (r *assetHostResource) findCreatedHost(ctx context.Context, name, address string, org client.RequestOption) (map[string]interface{}, error) {
+	queryParams := url.Values{}
+	queryParams.Add("name", name)
+	queryParams.Add("address", address)
+	apiPath := "/api/v1/assets/hosts/?" + queryParams.Encode()
+
+	for attempt := 1; ; attempt++ {
+		var hosts []map[string]interface{}
+		if err := r.client.api.List(ctx, apiPath, &hosts, org); err != nil {
+			return nil, err
+		}
+		if len(hosts) == 1 {
+			return hosts[0], nil
+		}
+		if len(hosts) > 1 {
+			return nil, fmt.Errorf("found %d assets named %q with address %q", len(hosts), name, address)
+		}
+
+		if attempt == createLookupAttempts {
+			return nil, fmt.Errorf("no asset named %q with address %q found after %d attempts", name, address, attempt)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(createLookupInterval):
+		}
+	}
+}
+
+// 读取资源
+func (r *assetHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerHostResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// 导入时状态中只有 ID 等少数属性，name 为必填，仅在导入时为 null
+	importing := state.Name.IsNull()
+
+	result, err := r.client.getHost(ctx, state.ID.ValueString(), orgOption(state.OrgID))
+	// 资产已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read asset hosts", "asset view", nil)
+		return
+	}
+
+	// 更新状态，字段类型与预期不符时报错而不是 panic
+	if name, ok := decodeStringField(&resp.Diagnostics, result, "name"); ok {
+		state.Name = types.StringValue(name)
+	}
+	if ip, ok := decodeStringField(&resp.Diagnostics, result, "address"); ok {
+		state.IP = types.StringValue(ip)
+	}
+	if platform, ok := decodePlatformField(&resp.Diagnostics, result); ok {
+		state.Platform = platformStateValue(ctx, r.client, state.Platform, platform)
+	}
+	serverProtocols, protocolsOk := decodeListField(&resp.Diagnostics, result, "protocols")
+	nodes, nodesOk := decodeListField(&resp.Diagnostics, result, "nodes_display")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// 刷新协议：合并模式下只保留由 Terraform 管理的协议
+	if protocolsOk {
+		var prior []ProtocolModel
+		if !state.Protocols.IsNull() && !state.Protocols.IsUnknown() {
+			resp.Diagnostics.Append(state.Protocols.ElementsAs(ctx, &prior, false)...)
+		}
+		if !state.ManageProtocolsExclusively.IsNull() && !state.ManageProtocolsExclusively.ValueBool() {
+			serverProtocols = filterManagedProtocols(serverProtocols, managedProtocolNames(prior, state.ProtocolsSimple))
+		}
+		refreshed := flattenHostProtocols(prior, serverProtocols)
+
+		if !state.ProtocolsSimple.IsNull() {
+			simple := make(map[string]attr.Value, len(refreshed))
+			for name, port := range simpleProtocolPorts(state.ProtocolsSimple, refreshed) {
+				simple[name] = port
+			}
+			state.ProtocolsSimple = types.MapValueMust(types.Int64Type, simple)
+		} else {
+			protocolsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: protocolAttrTypes}, refreshed)
+			resp.Diagnostics.Append(diags...)
+			state.Protocols = protocolsList
+		}
+	}
+
+	// 刷新节点：状态为 null 且服务端为空时保持 null
+	if nodesOk {
+		unique, _ := dedupeNodes(nodes)
+		if len(unique) > 0 || !state.NodesDisplay.IsNull() {
+			nodesList, diags := types.ListValueFrom(ctx, types.StringType, unique)
+			resp.Diagnostics.Append(diags...)
+			state.NodesDisplay = nodesList
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Labels, state.LabelsCount = flattenAssetLabels(state.Labels, result["labels"])
+	state.DateCreated, state.CreatedBy = flattenAssetProvenance(result)
+	state.Raw = flattenUnmodeledFields(result)
+	state.ProtocolsConnectivity = flattenProtocolConnectivity(result)
+	state.Connectivity = flattenAssetConnectivity(result)
+	if _, duplicates := dedupeNodes(nodes); len(duplicates) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("nodes_display"),
+			"Duplicate Node Memberships",
+			fmt.Sprintf("JumpServer returned the asset host in the same node more than once: %s. "+
+				"Duplicate memberships are ignored.", strings.Join(duplicates, ", ")),
+		)
+	}
+	// region：状态中有值或导入时才从 custom_info 恢复，未配置时保持 null
+	if !state.Region.IsNull() || importing {
+		state.Region = types.StringNull()
+		if customInfo, ok := result["custom_info"].(map[string]interface{}); ok {
+			if region, ok := customInfo["region"].(string); ok {
+				state.Region = types.StringValue(region)
+			}
+		}
+	}
+	// 备注：状态为 null 且服务端为空时保持 null
+	if comment, ok := decodeStringField(&resp.Diagnostics, result, "comment"); ok && (comment != "" || !state.Comment.IsNull()) {
+		state.Comment = types.StringValue(comment)
+	}
+	if isActive, ok := result["is_active"].(bool); ok {
+		state.IsActive = types.BoolValue(isActive)
+	}
+	// 资产详情不返回账号，按资产查询账号列表刷新由 Terraform 创建的账号
+	if !state.Accounts.IsNull() && !state.Accounts.IsUnknown() {
+		var prior []HostAccountModel
+		resp.Diagnostics.Append(state.Accounts.ElementsAs(ctx, &prior, false)...)
+		accounts, err := r.client.listAccounts(ctx, url.Values{"asset": {state.ID.ValueString()}}, orgOption(state.OrgID))
+		if err != nil {
+			addAPIError(&resp.Diagnostics, err, "read accounts", "account view", nil)
+			return
+		}
+		accountsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: hostAccountAttrTypes}, flattenHostAccounts(prior, accounts))
+		resp.Diagnostics.Append(diags...)
+		state.Accounts = accountsList
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// 更新资源
+func (r *assetHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerHostResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// 整个更新（包括连通性检查）受更新超时限制
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultHostUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// ID 为计算属性，不在计划中，沿用状态中的值
+	id := state.ID.ValueString()
+	plan.ID = state.ID
+
+	asset, diags := buildHostPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if asset["platform"], diags = resolvePayloadPlatform(ctx, r.client, plan.Platform); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// 合并模式：保留服务端存在但配置中未声明的协议
+	if !plan.ManageProtocolsExclusively.ValueBool() {
+		current, err := r.client.getHost(ctx, id, orgOption(plan.OrgID))
+		if err != nil {
+			addAPIError(&resp.Diagnostics, err, fmt.Sprintf("read current protocols of asset %s", id), "asset view", nil)
+			return
+		}
+		planned, _ := asset["protocols"].([]map[string]interface{})
+		serverProtocols := listField(current, "protocols")
+		asset["protocols"] = mergeServerProtocols(planned, serverProtocols)
+	}
+
+	// 节点不随 PATCH 整体替换，而是通过节点接口只添加和移除变化的节点
+	plannedNodes, _ := asset["nodes_display"].([]string)
+	delete(asset, "nodes_display")
+	var priorNodes []string
+	resp.Diagnostics.Append(state.NodesDisplay.ElementsAs(ctx, &priorNodes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// 保留未建模的服务端字段，避免被清空
+	if err := mergeUnmodeledFields(asset, state.Raw); err != nil {
+		resp.Diagnostics.AddError("JSON Unmarshal Error", fmt.Sprintf("Unable to restore unmodeled fields: %v", err))
+		return
+	}
+	setHostRegion(asset, plan.Region)
+
+	var result map[string]interface{}
+	if err := r.client.api.Patch(ctx, fmt.Sprintf("/api/v1/assets/hosts/%s/", id), asset, &result, orgOption(plan.OrgID)); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update asset hosts", "asset management", hostFieldPaths)
+		return
+	}
+	if err := r.client.moveHostNodes(ctx, id, plan.OrgID.ValueString(), priorNodes, plannedNodes); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update node assets", "asset management", nil)
+		return
+	}
+
+	// 更新计算属性
+	plan.Labels, plan.LabelsCount = flattenAssetLabels(plan.Labels, result["labels"])
+	plan.DateCreated, plan.CreatedBy = flattenAssetProvenance(result)
+	plan.Raw = flattenUnmodeledFields(result)
+	if plan.ProtocolsConnectivity.IsUnknown() {
+		plan.ProtocolsConnectivity = flattenProtocolConnectivity(result)
+	}
+	if plan.Connectivity.IsUnknown() {
+		plan.Connectivity = flattenAssetConnectivity(result)
+	}
+
+	// 地址变更后重新检查连通性；检查未能在超时前完成时只给出警告，地址更新本身已生效
+	if plan.VerifyOnAddressChange.ValueBool() && !plan.IP.Equal(state.IP) {
+		connectivity, err := r.verifyConnectivity(ctx, id, orgOption(plan.OrgID))
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("connectivity"),
+				"Connectivity Check Incomplete",
+				fmt.Sprintf("The address of asset host %s was updated, but the connectivity test did not complete: %s", id, err),
+			)
+		} else {
+			plan.Connectivity = connectivity
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 创建与更新资产的默认超时，可通过 timeouts.create 与 timeouts.update 调整
+const (
+	defaultHostCreateTimeout = 10 * time.Minute
+	defaultHostUpdateTimeout = 10 * time.Minute
+)
+
+// 填充随资产创建的账号的 verified：启用 verify_after_push 时验证账号密文，
+// 否则为 null。验证未完成时只给出警告，资产与账号已创建
+func (r *assetHostResource) setAccountsVerified(ctx context.Context, plan *JumpServerHostResourceModel, org client.RequestOption) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.Accounts.IsNull() || plan.Accounts.IsUnknown() {
+		return diags
+	}
+	var accounts []HostAccountModel
+	diags.Append(plan.Accounts.ElementsAs(ctx, &accounts, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var verified map[string]bool
+	if plan.VerifyAfterPush.ValueBool() && len(accounts) > 0 {
+		var err error
+		verified, err = r.verifyAccounts(ctx, plan.ID.ValueString(), org)
+		if err != nil {
+			diags.AddAttributeWarning(
+				path.Root("accounts"),
+				"Account Verification Incomplete",
+				fmt.Sprintf("The accounts of asset host %s were created, but verifying their secrets did not complete: %s", plan.ID.ValueString(), err),
+			)
+		}
+	}
+	for i := range accounts {
+		accounts[i].Verified = types.BoolNull()
+		if ok, found := verified[accounts[i].Name.ValueString()]; found {
+			accounts[i].Verified = types.BoolValue(ok)
+		}
+	}
+
+	list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: hostAccountAttrTypes}, accounts)
+	diags.Append(d...)
+	plan.Accounts = list
+	return diags
+}
+
+// 验证资产上账号的密文并等待任务结束，返回按账号名索引的验证结果
+func (r *assetHostResource) verifyAccounts(ctx context.Context, assetID string, org client.RequestOption) (map[string]bool, error) {
+	query := url.Values{"asset": {assetID}}
+	accounts, err := r.client.listAccounts(ctx, query, org)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		ids = append(ids, account.ID)
+	}
+
+	if _, err := r.runAccountTask(ctx, "verify", ids, org); err != nil {
+		return nil, err
+	}
+
+	// 验证结果记录在账号的连通性中
+	accounts, err = r.client.listAccounts(ctx, query, org)
+	if err != nil {
+		return nil, err
+	}
+	verified := make(map[string]bool, len(accounts))
+	for _, account := range accounts {
+		verified[account.Name] = account.Connectivity.Value == "ok"
+	}
+	return verified, nil
+}
+
+// 对账号执行 action（push、verify 等）任务并等待任务结束，返回任务 ID
+func (r *assetHostResource) runAccountTask(ctx context.Context, action string, ids []string, org client.RequestOption) (string, error) {
+	var task struct {
+		Task string `json:"task"`
+	}
+	body := map[string]interface{}{"action": action, "accounts": ids}
+	if err := r.client.api.Post(ctx, "/api/v1/accounts/accounts/tasks/", body, &task, org); err != nil {
+		return "", err
+	}
+	return task.Task, r.client.waitForTask(ctx, task.Task)
+}
+
+// 推送任务中每个资产上每个账号的执行记录
+type apiAccountTaskRecord struct {
+	Asset   objectRef   `json:"asset"`
+	Account objectRef   `json:"account"`
+	Status  choiceField `json:"status"`
+	Error   string      `json:"error"`
+}
+
+// 启用 push_accounts 时把随资产创建的账号推送到资产上。推送按资产与账号逐条记录结果，
+// 每条失败的记录给出一条警告，说明账号与原因，其余账号不受影响
+func (r *assetHostResource) pushAccounts(ctx context.Context, plan JumpServerHostResourceModel, org client.RequestOption) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !plan.PushAccounts.ValueBool() || plan.Accounts.IsNull() || plan.Accounts.IsUnknown() {
+		return diags
+	}
+	var models []HostAccountModel
+	diags.Append(plan.Accounts.ElementsAs(ctx, &models, false)...)
+	if diags.HasError() || len(models) == 0 {
+		return diags
+	}
+
+	records, names, err := r.runAccountPush(ctx, plan.ID.ValueString(), org)
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root("accounts"),
+			"Account Push Incomplete",
+			fmt.Sprintf("The accounts of asset host %s were created, but pushing them to the asset did not complete: %s", plan.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	for _, record := range records {
+		if record.Status.Value != "failed" {
+			continue
+		}
+		attrPath := path.Root("accounts")
+		for i, model := range models {
+			if model.Name.ValueString() == names[record.Account.ID] {
+				attrPath = attrPath.AtListIndex(i)
+				break
+			}
+		}
+		diags.AddAttributeWarning(
+			attrPath,
+			"Account Push Failed",
+			fmt.Sprintf("Pushing account %q to asset %s (%s) failed: %s", names[record.Account.ID], plan.Name.ValueString(), record.Asset.ID, record.Error),
+		)
+	}
+	return diags
+}
+
+// 推送资产上的账号并等待任务结束，返回任务的执行记录与按账号 ID 索引的账号名
+func (r *assetHostResource) runAccountPush(ctx context.Context, assetID string, org client.RequestOption) ([]apiAccountTaskRecord, map[string]string, error) {
+	accounts, err := r.client.listAccounts(ctx, url.Values{"asset": {assetID}}, org)
+	if err != nil {
+		return nil, nil, err
+	}
+	ids := make([]string, 0, len(accounts))
+	names := make(map[string]string, len(accounts))
+	for _, account := range accounts {
+		ids = append(ids, account.ID)
+		names[account.ID] = account.Name
+	}
+
+	taskID, err := r.runAccountTask(ctx, "push", ids, org)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 推送复用改密的执行记录，执行 ID 即任务 ID
+	var records []apiAccountTaskRecord
+	query := url.Values{"execution": {taskID}}
+	if err := r.client.api.List(ctx, "/api/v1/accounts/change-secret-records/?"+query.Encode(), &records, org); err != nil {
+		return nil, nil, err
+	}
+	return records, names, nil
+}
+
+// 对资产执行连通性测试并等待任务结束，返回测试后的连通性状态
+func (r *assetHostResource) verifyConnectivity(ctx context.Context, id string, org client.RequestOption) (types.String, error) {
+	var task struct {
+		Task string `json:"task"`
+	}
+	body := map[string]interface{}{"action": "test"}
+	if err := r.client.api.Post(ctx, fmt.Sprintf("/api/v1/assets/assets/%s/tasks/", id), body, &task, org); err != nil {
+		return types.StringNull(), err
+	}
+	if err := r.client.waitForTask(ctx, task.Task); err != nil {
+		return types.StringNull(), err
+	}
+
+	result, err := r.client.getHost(ctx, id, org)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return flattenAssetConnectivity(result), nil
+}
+
+// 导入资源：按资产 UUID 导入，或按 "<组织 ID>:<UUID>" 从指定组织导入，其余属性由 Read 填充
+func (r *assetHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateWithOrg(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// 仅影响本地行为的属性不在 API 中，导入时使用默认值，避免导入后出现差异
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_strategy"), deleteStrategyDelete)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("terminate_sessions_on_destroy"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("manage_protocols_exclusively"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("verify_on_address_change"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("push_accounts"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("verify_after_push"), false)...)
+}
+
+// 调整资产所属的节点：先加入新增的节点，再移出不再需要的节点，资产在调整过程中始终属于至少一个节点
+func (c *jumpServerClient) moveHostNodes(ctx context.Context, assetID, orgID string, from, to []string) error {
+	current := make(map[string]bool, len(from))
+	for _, node := range from {
+		current[node] = true
+	}
+	wanted := make(map[string]bool, len(to))
+	for _, node := range to {
+		wanted[node] = true
+	}
+
+	var added, removed []string
+	for _, node := range to {
+		if !current[node] {
+			added = append(added, node)
+			current[node] = true
+		}
+	}
+	for _, node := range from {
+		if !wanted[node] {
+			removed = append(removed, node)
+			wanted[node] = true
+		}
+	}
+
+	for _, change := range []struct {
+		action string
+		nodes  []string
+	}{{"add", added}, {"remove", removed}} {
+		for _, node := range change.nodes {
+			nodeID, err := c.resolveNodeID(ctx, orgID, node)
+			if err != nil {
+				return err
+			}
+			tflog.Debug(ctx, "Updating node assets", map[string]interface{}{"node": node, "action": change.action, "asset_id": assetID})
+			body := map[string]interface{}{"assets": []string{assetID}}
+			if err := c.api.Put(ctx, fmt.Sprintf("/api/v1/assets/nodes/%s/assets/%s/", nodeID, change.action), body, nil, client.WithOrg(orgID)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// 获取资产详情
+func (c *jumpServerClient) getHost(ctx context.Context, id string, opts ...client.RequestOption) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if err := c.api.Get(ctx, fmt.Sprintf("/api/v1/assets/hosts/%s/", id), nil, &result, opts...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// 删除资源
+func (r *assetHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerHostResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// 获取资源 ID
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.Diagnostics.AddError("Missing ID", "Resource ID is required for deletion")
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting asset host", map[string]interface{}{
+		"id":              id,
+		"delete_strategy": state.DeleteStrategy.ValueString(),
+	})
+
+	apiPath := fmt.Sprintf("/api/v1/assets/hosts/%s/", id)
+	org := orgOption(state.OrgID)
+
+	// 销毁前终止该资产上的活动会话
+	if state.TerminateSessionsOnDestroy.ValueBool() {
+		if err := r.terminateSessions(ctx, id, org); err != nil {
+			addAPIError(&resp.Diagnostics, err, fmt.Sprintf("terminate active sessions on asset %s", id), "session management", nil)
+			return
+		}
+	}
+
+	// 停用模式：只将资产设为未激活，不调用 DELETE
+	if state.DeleteStrategy.ValueString() == deleteStrategyDeactivate {
+		r.deactivate(ctx, apiPath, org, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err := r.client.api.Delete(ctx, apiPath, nil, nil, org); err != nil {
+		addAPIError(&resp.Diagnostics, err, "delete asset hosts", "asset management", nil)
 		return
 	}
 
 	// 标记资源为已删除
 	resp.State.RemoveResource(ctx)
 }
+
+// 会话终止后等待其关闭的最长时间与轮询间隔
+const (
+	sessionDrainTimeout  = 30 * time.Second
+	sessionDrainInterval = 2 * time.Second
+)
+
+// 终止资产上的活动会话，并等待会话关闭
+func (r *assetHostResource) terminateSessions(ctx context.Context, id string, org client.RequestOption) error {
+	sessions, err := r.activeSessions(ctx, id, org)
+	if err != nil || len(sessions) == 0 {
+		return err
+	}
+
+	if err := r.client.api.Post(ctx, "/api/v1/terminal/tasks/kill-session/", sessions, nil, org); err != nil {
+		return err
+	}
+
+	// 等待会话关闭，超时后继续删除
+	deadline := time.Now().Add(sessionDrainTimeout)
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(sessionDrainInterval):
+		}
+
+		remaining, err := r.activeSessions(ctx, id, org)
+		if err != nil {
+			return err
+		}
+		if len(remaining) == 0 {
+			return nil
+		}
+	}
+	tflog.Warn(ctx, "Sessions still active after termination, proceeding with delete", map[string]interface{}{"asset_id": id})
+	return nil
+}
+
+// 查询资产上未结束的会话 ID
+func (r *assetHostResource) activeSessions(ctx context.Context, id string, org client.RequestOption) ([]string, error) {
+	queryParams := url.Values{}
+	queryParams.Add("asset", id)
+	queryParams.Add("is_finished", "false")
+	type session struct {
+		ID string `json:"id"`
+	}
+	var sessions []session
+	if err := r.client.api.List(ctx, "/api/v1/terminal/sessions/?"+queryParams.Encode(), &sessions, org); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(sessions))
+	for _, s := range sessions {
+		ids = append(ids, s.ID)
+	}
+	return ids, nil
+}
+
+// 停用资产：PATCH is_active=false
+func (r *assetHostResource) deactivate(ctx context.Context, apiPath string, org client.RequestOption, resp *resource.DeleteResponse) {
+	if err := r.client.api.Patch(ctx, apiPath, map[string]interface{}{"is_active": false}, nil, org); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update asset hosts", "asset management", nil)
+	}
+}
+
+// 展开随资产创建的账号，未设置密文时不发送
+func expandHostAccounts(accounts []HostAccountModel) []map[string]interface{} {
+	expanded := make([]map[string]interface{}, 0, len(accounts))
+	for _, account := range accounts {
+		item := map[string]interface{}{
+			"name":        account.Name.ValueString(),
+			"username":    account.Username.ValueString(),
+			"secret_type": account.SecretType.ValueString(),
+			"privileged":  account.Privileged.ValueBool(),
+		}
+		if !account.Secret.IsNull() {
+			item["secret"] = account.Secret.ValueString()
+		}
+		expanded = append(expanded, item)
+	}
+	return expanded
+}
+
+// 按名称刷新状态中的账号，密文沿用状态中的值；JumpServer 中已删除的账号从列表中移除
+func flattenHostAccounts(prior []HostAccountModel, accounts []apiAccount) []HostAccountModel {
+	byName := make(map[string]apiAccount, len(accounts))
+	for _, account := range accounts {
+		byName[account.Name] = account
+	}
+
+	refreshed := make([]HostAccountModel, 0, len(prior))
+	for _, model := range prior {
+		account, ok := byName[model.Name.ValueString()]
+		if !ok {
+			continue
+		}
+		model.Username = types.StringValue(account.Username)
+		if account.SecretType.Value != "" {
+			model.SecretType = types.StringValue(account.SecretType.Value)
+		}
+		model.Privileged = types.BoolValue(account.Privileged)
+		refreshed = append(refreshed, model)
+	}
+	return refreshed
+}
+
+// 解析资产标签，兼容返回 ID 列表或标签对象列表两种格式；按 prior 中的顺序排列
+func flattenAssetLabels(prior types.List, raw interface{}) (types.List, types.Int64) {
+	items, _ := raw.([]interface{})
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		switch label := item.(type) {
+		case string:
+			ids = append(ids, label)
+		case map[string]interface{}:
+			if id, ok := label["id"].(string); ok {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	labels := make([]attr.Value, 0, len(ids))
+	for _, id := range orderLike(prior, ids) {
+		labels = append(labels, types.StringValue(id))
+	}
+	return types.ListValueMust(types.StringType, labels), types.Int64Value(int64(len(labels)))
+}
+
+// 解析资产的创建时间和创建者
+func flattenAssetProvenance(result map[string]interface{}) (types.String, types.String) {
+	dateCreated := types.StringNull()
+	if v, ok := result["date_created"].(string); ok {
+		dateCreated = types.StringValue(v)
+	}
+	createdBy := types.StringNull()
+	if v, ok := result["created_by"].(string); ok {
+		createdBy = types.StringValue(v)
+	}
+	return dateCreated, createdBy
+}
+
+// 合并模式：保留服务端存在但配置中未声明的协议
+func mergeServerProtocols(planned []map[string]interface{}, server []interface{}) []map[string]interface{} {
+	declared := make(map[string]bool, len(planned))
+	for _, proto := range planned {
+		if name, ok := proto["name"].(string); ok {
+			declared[strings.ToLower(name)] = true
+		}
+	}
+
+	merged := append([]map[string]interface{}{}, planned...)
+	for _, item := range server {
+		proto, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, ok := proto["name"].(string)
+		if !ok || declared[strings.ToLower(name)] {
+			continue
+		}
+		unmanaged := map[string]interface{}{"name": name}
+		if port, ok := proto["port"]; ok {
+			unmanaged["port"] = port
+		}
+		merged = append(merged, unmanaged)
+	}
+	return merged
+}
+
+// 合并模式：读取时只保留由 Terraform 管理的协议，避免服务端协议被识别为漂移。
+// managed 的键为小写协议名
+func filterManagedProtocols(server []interface{}, managed map[string]bool) []interface{} {
+	filtered := make([]interface{}, 0, len(server))
+	for _, item := range server {
+		proto, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, ok := proto["name"].(string); ok && managed[strings.ToLower(name)] {
+			filtered = append(filtered, proto)
+		}
+	}
+	return filtered
+}
+
+// 将 protocols_simple（协议名 -> 端口）展开为按名称排序的协议对象
+func expandSimpleProtocols(simple types.Map) []map[string]interface{} {
+	elements := simple.Elements()
+	names := make([]string, 0, len(elements))
+	for name := range elements {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	protocols := make([]map[string]interface{}, 0, len(names))
+	for _, name := range names {
+		protocol := map[string]interface{}{"name": strings.ToLower(name)}
+		if port, ok := elements[name].(types.Int64); ok && !port.IsNull() {
+			protocol["port"] = port.ValueInt64()
+		}
+		protocols = append(protocols, protocol)
+	}
+	return protocols
+}
+
+// 保存 API 返回中未建模的字段，避免更新时被清空
+func flattenUnmodeledFields(result map[string]interface{}) types.String {
+	unmodeled := make(map[string]interface{}, len(result))
+	for key, value := range result {
+		if !hostModeledFields[key] {
+			unmodeled[key] = value
+		}
+	}
+
+	raw, err := json.Marshal(unmodeled)
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(string(raw))
+}
+
+// 更新时将可写的未建模字段合并回请求体，已建模字段以计划值为准
+func mergeUnmodeledFields(payload map[string]interface{}, raw types.String) error {
+	if raw.IsNull() || raw.IsUnknown() || raw.ValueString() == "" {
+		return nil
+	}
+
+	var unmodeled map[string]interface{}
+	if err := json.Unmarshal([]byte(raw.ValueString()), &unmodeled); err != nil {
+		return err
+	}
+	for key, value := range unmodeled {
+		if _, ok := payload[key]; !ok && hostPreservedFields[key] {
+			payload[key] = value
+		}
+	}
+	return nil
+}
+
+// 去除重复的节点，返回去重后的节点以及重复出现的节点
+func dedupeNodes(nodes []interface{}) ([]string, []string) {
+	seen := make(map[string]bool, len(nodes))
+	unique := make([]string, 0, len(nodes))
+	var duplicates []string
+	for _, item := range nodes {
+		node, ok := item.(string)
+		if !ok {
+			continue
+		}
+		if seen[node] {
+			duplicates = append(duplicates, node)
+			continue
+		}
+		seen[node] = true
+		unique = append(unique, node)
+	}
+	return unique, duplicates
+}
+
+// 读取字符串字段：字段缺失或为 null 时返回 false，类型不符时记录诊断
+func decodeStringField(diags *diag.Diagnostics, result map[string]interface{}, key string) (string, bool) {
+	raw, present := result[key]
+	if !present || raw == nil {
+		return "", false
+	}
+	value, ok := raw.(string)
+	if !ok {
+		diags.AddError("Unexpected API Response", fmt.Sprintf("Expected %q to be a string, got %T", key, raw))
+		return "", false
+	}
+	return value, true
+}
+
+// 读取数组字段：字段缺失或为 null 时返回 false，类型不符时记录诊断
+func decodeListField(diags *diag.Diagnostics, result map[string]interface{}, key string) ([]interface{}, bool) {
+	raw, present := result[key]
+	if !present || raw == nil {
+		return nil, false
+	}
+	value, ok := raw.([]interface{})
+	if !ok {
+		diags.AddError("Unexpected API Response", fmt.Sprintf("Expected %q to be an array, got %T", key, raw))
+		return nil, false
+	}
+	return value, true
+}
+
+// 安全读取字符串字段：字段缺失、为 null 或类型不符时返回空字符串，不会 panic
+func stringField(result map[string]interface{}, key string) string {
+	value, _ := result[key].(string)
+	return value
+}
+
+// 安全读取数组字段：字段缺失、为 null 或类型不符时返回 nil，不会 panic
+func listField(result map[string]interface{}, key string) []interface{} {
+	value, _ := result[key].([]interface{})
+	return value
+}
+
+// 读取平台字段：兼容平台 ID 字符串与 {"id": ..., "name": ...} 对象两种格式
+func decodePlatformField(diags *diag.Diagnostics, result map[string]interface{}) (string, bool) {
+	switch platform := result["platform"].(type) {
+	case nil:
+		return "", false
+	case string:
+		return platform, true
+	case map[string]interface{}:
+		switch id := platform["id"].(type) {
+		case float64:
+			return strconv.FormatInt(int64(id), 10), true
+		case string:
+			return id, true
+		}
+	}
+	diags.AddError("Unexpected API Response", fmt.Sprintf("Unable to read platform from %T", result["platform"]))
+	return "", false
+}
+
+// 平台可以写 ID 或名称，请求前统一解析为 ID，并按服务端版本构造平台字段
+func resolvePayloadPlatform(ctx context.Context, client *jumpServerClient, platform types.String) (interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	platformID, err := client.resolvePlatformID(ctx, platform.ValueString())
+	if err != nil {
+		warnIfRateLimited(&diags, err, "resolve platforms")
+		diags.AddAttributeError(path.Root("platform"), "Invalid Platform", fmt.Sprintf("Unable to resolve platform %q: %s", platform.ValueString(), err))
+		return nil, diags
+	}
+	return client.platformRef(platformID), diags
+}
+
+// 服务端返回平台 ID；状态中的名称指向同一平台时保留名称，避免产生差异
+func platformStateValue(ctx context.Context, client *jumpServerClient, prior types.String, platformID string) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if id, err := client.resolvePlatformID(ctx, prior.ValueString()); err == nil && id == platformID {
+			return prior
+		}
+	}
+	return types.StringValue(platformID)
+}
+
+// 解析资产的连通性状态，兼容字符串与 {"value": ..., "label": ...} 两种格式；API 未提供时返回 null
+func flattenAssetConnectivity(result map[string]interface{}) types.String {
+	switch connectivity := result["connectivity"].(type) {
+	case string:
+		return types.StringValue(connectivity)
+	case map[string]interface{}:
+		if value, ok := connectivity["value"].(string); ok {
+			return types.StringValue(value)
+		}
+	}
+	return types.StringNull()
+}
+
+// 解析各协议的连通性状态；API 未提供时返回 null
+func flattenProtocolConnectivity(result map[string]interface{}) types.Map {
+	protocols := listField(result, "protocols")
+	statuses := map[string]attr.Value{}
+	for _, item := range protocols {
+		proto, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, ok := proto["name"].(string)
+		if !ok {
+			continue
+		}
+		switch connectivity := proto["connectivity"].(type) {
+		case string:
+			statuses[name] = types.StringValue(connectivity)
+		case map[string]interface{}:
+			if value, ok := connectivity["value"].(string); ok {
+				statuses[name] = types.StringValue(value)
+			}
+		}
+	}
+
+	if len(statuses) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	return types.MapValueMust(types.StringType, statuses)
+}
+
+// 由 API 返回的协议重建状态中的协议列表。
+// 已有协议保持状态中的顺序和名称写法；状态中端口为 null 的协议保持 null，避免未配置的端口产生差异；
+// 服务端新增的协议追加在末尾。
+func flattenHostProtocols(prior []ProtocolModel, server []interface{}) []ProtocolModel {
+	serverPorts := make(map[string]types.Int64, len(server))
+	var serverOrder []string
+	for _, item := range server {
+		proto, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, ok := proto["name"].(string)
+		if !ok {
+			continue
+		}
+		port := types.Int64Null()
+		if v, ok := proto["port"].(float64); ok {
+			port = types.Int64Value(int64(v))
+		}
+		key := strings.ToLower(name)
+		if _, exists := serverPorts[key]; !exists {
+			serverOrder = append(serverOrder, name)
+		}
+		serverPorts[key] = port
+	}
+
+	refreshed := make([]ProtocolModel, 0, len(serverPorts))
+	seen := make(map[string]bool, len(prior))
+	for _, proto := range prior {
+		key := strings.ToLower(proto.Name.ValueString())
+		port, ok := serverPorts[key]
+		if !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+		if proto.Port.IsNull() {
+			port = types.Int64Null()
+		}
+		refreshed = append(refreshed, ProtocolModel{Name: proto.Name, Port: port})
+	}
+	for _, name := range serverOrder {
+		key := strings.ToLower(name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		refreshed = append(refreshed, ProtocolModel{Name: types.StringValue(name), Port: serverPorts[key]})
+	}
+	return refreshed
+}
+
+// 由 Terraform 管理的协议名称（小写），来自 protocols 或 protocols_simple
+func managedProtocolNames(prior []ProtocolModel, simple types.Map) map[string]bool {
+	managed := make(map[string]bool, len(prior))
+	for _, proto := range prior {
+		managed[strings.ToLower(proto.Name.ValueString())] = true
+	}
+	for name := range simple.Elements() {
+		managed[strings.ToLower(name)] = true
+	}
+	return managed
+}
+
+// 按 protocols_simple 的键名写法返回刷新后的端口
+func simpleProtocolPorts(simple types.Map, refreshed []ProtocolModel) map[string]types.Int64 {
+	keys := make(map[string]string, len(simple.Elements()))
+	for name := range simple.Elements() {
+		keys[strings.ToLower(name)] = name
+	}
+
+	ports := make(map[string]types.Int64, len(refreshed))
+	for _, proto := range refreshed {
+		name := proto.Name.ValueString()
+		if key, ok := keys[strings.ToLower(name)]; ok {
+			name = key
+		}
+		ports[name] = proto.Port
+	}
+	return ports
+}
@@ -0,0 +1,1054 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+const testHostsPath = "/api/v1/assets/hosts/"
+
+func TestAccAssetHostResource(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, testHostsPath, "jumpserver_asset_host"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccAssetHostConfig("10.0.0.5", "web server"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("jumpserver_asset_host.test", "id"),
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "name", "web-01"),
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "ip", "10.0.0.5"),
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "platform", "Linux"),
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "comment", "web server"),
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "is_active", "true"),
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "protocols.#", "1"),
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "protocols.0.port", "22"),
+					testAccCheckHostField(server, "jumpserver_asset_host.test", "address", "10.0.0.5"),
+					testAccCheckHostField(server, "jumpserver_asset_host.test", "platform", testPlatformLinux),
+				),
+			},
+			{
+				ResourceName:      "jumpserver_asset_host.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Read keeps the platform name only when state already holds it;
+				// an imported host has the platform ID.
+				ImportStateVerifyIgnore: []string{"platform"},
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccAssetHostConfig("10.0.0.6", "moved"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "ip", "10.0.0.6"),
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "comment", "moved"),
+					testAccCheckHostField(server, "jumpserver_asset_host.test", "address", "10.0.0.6"),
+					testAccCheckHostField(server, "jumpserver_asset_host.test", "comment", "moved"),
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources["jumpserver_asset_host.test"].Primary.ID
+						for _, req := range server.requestsTo(http.MethodPatch, testHostsPath+id+"/") {
+							if body, _ := req.Body.(map[string]interface{}); body["date_updated"] != nil {
+								return fmt.Errorf("PATCH sent the read-only field date_updated: %v", body)
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccAssetHostConfig(address, comment string) string {
+	return fmt.Sprintf(`
+resource "jumpserver_asset_host" "test" {
+  name          = "web-01"
+  ip            = %q
+  platform      = "Linux"
+  nodes_display = ["/Default"]
+  comment       = %q
+  protocols = [
+    { name = "ssh" },
+  ]
+}
+`, address, comment)
+}
+
+// testAccCheckHostField checks a field of the asset host as stored by the
+// test server.
+func testAccCheckHostField(server *testServer, name, field, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("%s not found in state", name)
+		}
+		host, ok := server.object(testHostsPath, rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("asset host %s not found on the server", rs.Primary.ID)
+		}
+		if got := fmt.Sprint(host[field]); got != want {
+			return fmt.Errorf("asset host %s: %s = %q, want %q", rs.Primary.ID, field, got, want)
+		}
+		return nil
+	}
+}
+
+// testAccCheckDestroyed checks that no resource of resourceType in state is
+// left in collection on the test server.
+func testAccCheckDestroyed(server *testServer, collection, resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			if _, ok := server.object(collection, rs.Primary.ID); ok {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+// handleNodeAssets serves the node add and remove endpoints for the nodes in
+// nodes (path -> ID), keeping nodes_display of the asset hosts up to date.
+// It calls onChange with the host's nodes after every change.
+func handleNodeAssets(server *testServer, nodes map[string]string, onChange func(nodes []interface{})) {
+	for fullValue, id := range nodes {
+		fullValue := fullValue
+		server.put("/api/v1/assets/nodes/", map[string]interface{}{
+			"id":         id,
+			"value":      fullValue[strings.LastIndex(fullValue, "/")+1:],
+			"full_value": fullValue,
+		})
+		for _, action := range []string{"add", "remove"} {
+			action := action
+			server.handle(http.MethodPut, fmt.Sprintf("/api/v1/assets/nodes/%s/assets/%s/", id, action), func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					Assets []string `json:"assets"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					writeTestJSON(w, http.StatusBadRequest, map[string]interface{}{"detail": err.Error()})
+					return
+				}
+				for _, assetID := range body.Assets {
+					host, ok := server.object(testHostsPath, assetID)
+					if !ok {
+						writeTestJSON(w, http.StatusBadRequest, map[string]interface{}{"assets": []string{"Invalid asset " + assetID}})
+						return
+					}
+					var updated []interface{}
+					for _, node := range listField(host, "nodes_display") {
+						if node != fullValue {
+							updated = append(updated, node)
+						}
+					}
+					if action == "add" {
+						updated = append(updated, fullValue)
+					}
+					server.update(testHostsPath, assetID, map[string]interface{}{"nodes_display": updated})
+					onChange(updated)
+				}
+				writeTestJSON(w, http.StatusOK, map[string]interface{}{})
+			})
+		}
+	}
+}
+
+func TestMoveHostNodes(t *testing.T) {
+	server := newTestServer(t)
+	var memberships [][]interface{}
+	handleNodeAssets(server, map[string]string{
+		"/Default/Web": "6a8a3c0e-3f4b-4b7e-9c1d-2e5f6a7b8c9d",
+		"/Default/DB":  "0d9e8f7a-6b5c-4d3e-2f1a-0b9c8d7e6f5a",
+		"/Default/App": "5e4d3c2b-1a0f-4e9d-8c7b-6a5f4e3d2c1b",
+	}, func(nodes []interface{}) {
+		memberships = append(memberships, nodes)
+	})
+	hostID := server.put(testHostsPath, map[string]interface{}{"name": "web-01", "nodes_display": []string{"/Default/Web", "/Default/App"}})
+	c := newTestClient(server)
+
+	err := c.moveHostNodes(context.Background(), hostID, "", []string{"/Default/Web", "/Default/App"}, []string{"/Default/DB", "/Default/App"})
+	if err != nil {
+		t.Fatalf("moveHostNodes() error = %v", err)
+	}
+
+	for i, nodes := range memberships {
+		if len(nodes) == 0 {
+			t.Errorf("host in no node after change %d", i+1)
+		}
+	}
+	if len(memberships) != 2 {
+		t.Errorf("%d node changes, want 2 (only the moved node)", len(memberships))
+	}
+	host, _ := server.object(testHostsPath, hostID)
+	if got := fmt.Sprint(host["nodes_display"]); got != "[/Default/App /Default/DB]" {
+		t.Errorf("host nodes = %s, want [/Default/App /Default/DB]", got)
+	}
+	if got := len(server.requestsTo(http.MethodPut, "/api/v1/assets/nodes/5e4d3c2b-1a0f-4e9d-8c7b-6a5f4e3d2c1b/assets/remove/")); got != 0 {
+		t.Errorf("unchanged node /Default/App was updated %d times", got)
+	}
+}
+
+func TestVerifyConnectivity(t *testing.T) {
+	server := newTestServer(t)
+	hostID := server.put(testHostsPath, map[string]interface{}{"name": "web-01", "connectivity": map[string]interface{}{"value": "-", "label": "Unknown"}})
+	server.handle(http.MethodPost, "/api/v1/assets/assets/"+hostID+"/tasks/", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusCreated, map[string]interface{}{"task": "connectivity"})
+	})
+	server.handle(http.MethodGet, "/api/v1/ops/celery/task/connectivity/result/", func(w http.ResponseWriter, r *http.Request) {
+		server.update(testHostsPath, hostID, map[string]interface{}{"connectivity": map[string]interface{}{"value": "ok", "label": "OK"}})
+		writeTestJSON(w, http.StatusOK, map[string]interface{}{"status": "SUCCESS"})
+	})
+	r := &assetHostResource{client: newTestClient(server)}
+
+	connectivity, err := r.verifyConnectivity(context.Background(), hostID, client.WithOrg(""))
+	if err != nil {
+		t.Fatalf("verifyConnectivity() error = %v", err)
+	}
+	if connectivity.ValueString() != "ok" {
+		t.Errorf("connectivity = %s, want ok", connectivity)
+	}
+	requests := server.requestsTo(http.MethodPost, "/api/v1/assets/assets/"+hostID+"/tasks/")
+	if len(requests) != 1 || fmt.Sprint(requests[0].Body) != "map[action:test]" {
+		t.Errorf("connectivity test requests = %v, want one with action test", requests)
+	}
+}
+
+func TestAccAssetHostResource_inactive(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, testHostsPath, "jumpserver_asset_host"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccAssetHostActiveConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "is_active", "false"),
+					func(s *terraform.State) error {
+						host, _ := server.object(testHostsPath, s.RootModule().Resources["jumpserver_asset_host.test"].Primary.ID)
+						if active, ok := host["is_active"].(bool); !ok || active {
+							return fmt.Errorf("is_active stored as %#v, want the JSON boolean false", host["is_active"])
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Read maps the API's "is_active": false back to false.
+				ResourceName:            "jumpserver_asset_host.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"platform"},
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccAssetHostActiveConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "is_active", "true"),
+					testAccCheckHostField(server, "jumpserver_asset_host.test", "is_active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssetHostActiveConfig(active bool) string {
+	return fmt.Sprintf(`
+resource "jumpserver_asset_host" "test" {
+  name          = "web-01"
+  ip            = "10.0.0.5"
+  platform      = "Linux"
+  nodes_display = ["/Default"]
+  is_active     = %t
+  protocols = [
+    { name = "ssh" },
+  ]
+}
+`, active)
+}
+
+func TestAccAssetHostResource_multilineComment(t *testing.T) {
+	server := newTestServer(t)
+	comment := "Owner: \"ops\" <ops@example.com>\nBackslash \\ and tab\t\n机房：上海 ✓"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, testHostsPath, "jumpserver_asset_host"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccAssetHostConfig("10.0.0.5", comment),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "comment", comment),
+					testAccCheckHostField(server, "jumpserver_asset_host.test", "comment", comment),
+				),
+			},
+			{
+				ResourceName:            "jumpserver_asset_host.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"platform"},
+			},
+		},
+	})
+}
+
+func TestAccAssetHostResource_protocolsConnectivity(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, testHostsPath, "jumpserver_asset_host"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccAssetHostProtocolsConfig("ssh = 22"),
+				Check:  resource.TestCheckNoResourceAttr("jumpserver_asset_host.test", "protocols_connectivity.%"),
+			},
+			{
+				// JumpServer reports the result of a connectivity test.
+				PreConfig: func() {
+					for _, host := range server.list(testHostsPath) {
+						server.update(testHostsPath, fmt.Sprint(host["id"]), map[string]interface{}{
+							"protocols": []interface{}{
+								map[string]interface{}{"name": "ssh", "port": 22, "connectivity": map[string]interface{}{"value": "ok"}},
+							},
+						})
+					}
+				},
+				Config: testAccProviderConfig(server) + testAccAssetHostProtocolsConfig("ssh = 22"),
+				Check:  resource.TestCheckResourceAttr("jumpserver_asset_host.test", "protocols_connectivity.ssh", "ok"),
+			},
+			{
+				// Changing the protocols replaces the server's statuses, which are
+				// planned as unknown instead of kept from state.
+				Config: testAccProviderConfig(server) + testAccAssetHostProtocolsConfig("ssh = 2222"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "protocols_simple.ssh", "2222"),
+					resource.TestCheckNoResourceAttr("jumpserver_asset_host.test", "protocols_connectivity.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssetHostProtocolsConfig(protocols string) string {
+	return fmt.Sprintf(`
+resource "jumpserver_asset_host" "test" {
+  name          = "web-01"
+  ip            = "10.0.0.5"
+  platform      = "Linux"
+  nodes_display = ["/Default"]
+  protocols_simple = {
+    %s
+  }
+}
+`, protocols)
+}
+
+func TestAccAssetHostResource_deletedOutsideTerraform(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccAssetHostConfig("10.0.0.5", "web server"),
+			},
+			{
+				// A host deleted in JumpServer is removed from state and planned for creation.
+				PreConfig: func() {
+					for _, host := range server.list(testHostsPath) {
+						server.remove(testHostsPath, fmt.Sprint(host["id"]))
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: func(s *terraform.State) error {
+					if _, ok := s.RootModule().Resources["jumpserver_asset_host.test"]; ok {
+						return fmt.Errorf("deleted asset host still in state")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestMergeUnmodeledFields(t *testing.T) {
+	raw := types.StringValue(`{"domain":{"id":"d1"},"custom_info":{"rack":"A1"},"date_updated":"2024/01/02 03:04:05 +0800","connectivity":"ok","gathered_info":{}}`)
+	payload := map[string]interface{}{"name": "web-01", "custom_info": map[string]interface{}{"region": "cn-north-1"}}
+
+	if err := mergeUnmodeledFields(payload, raw); err != nil {
+		t.Fatalf("mergeUnmodeledFields() error = %v", err)
+	}
+	if got := fmt.Sprint(payload["domain"]); got != "map[id:d1]" {
+		t.Errorf("domain = %s, want the preserved value", got)
+	}
+	if got := fmt.Sprint(payload["custom_info"]); got != "map[region:cn-north-1]" {
+		t.Errorf("custom_info = %s, want the planned value", got)
+	}
+	for _, field := range []string{"date_updated", "connectivity", "gathered_info"} {
+		if _, ok := payload[field]; ok {
+			t.Errorf("read-only field %s sent back", field)
+		}
+	}
+}
+
+func TestAccAssetHostResource_protocolsMerge(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccAssetHostExclusiveConfig(false, "web server"),
+			},
+			{
+				// A protocol added in JumpServer is not drift in merge mode.
+				PreConfig: testAccAddHostSFTP(server),
+				Config:    testAccProviderConfig(server) + testAccAssetHostExclusiveConfig(false, "web server"),
+				PlanOnly:  true,
+			},
+			{
+				// Updates keep the unmanaged protocol.
+				Config: testAccProviderConfig(server) + testAccAssetHostExclusiveConfig(false, "frontend"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "protocols.#", "1"),
+					testAccCheckHostField(server, "jumpserver_asset_host.test", "comment", "frontend"),
+					testAccCheckHostField(server, "jumpserver_asset_host.test", "protocols", "[map[name:ssh port:22] map[name:sftp port:2222]]"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAssetHostResource_protocolsExclusive(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccAssetHostExclusiveConfig(true, "web server"),
+			},
+			{
+				// A protocol added in JumpServer is drift and is removed on apply.
+				PreConfig: testAccAddHostSFTP(server),
+				Config:    testAccProviderConfig(server) + testAccAssetHostExclusiveConfig(true, "web server"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "protocols.#", "1"),
+					testAccCheckHostField(server, "jumpserver_asset_host.test", "protocols", "[map[name:ssh port:22]]"),
+				),
+			},
+		},
+	})
+}
+
+// testAccAddHostSFTP returns a PreConfig that adds sftp to every host on the
+// server, as a change made in JumpServer would.
+func testAccAddHostSFTP(server *testServer) func() {
+	return func() {
+		for _, host := range server.list(testHostsPath) {
+			server.update(testHostsPath, fmt.Sprint(host["id"]), map[string]interface{}{
+				"protocols": []interface{}{
+					map[string]interface{}{"name": "ssh", "port": 22},
+					map[string]interface{}{"name": "sftp", "port": 2222},
+				},
+			})
+		}
+	}
+}
+
+func testAccAssetHostExclusiveConfig(exclusive bool, comment string) string {
+	return fmt.Sprintf(`
+resource "jumpserver_asset_host" "test" {
+  name                         = "web-01"
+  ip                           = "10.0.0.5"
+  platform                     = "Linux"
+  nodes_display                = ["/Default"]
+  comment                      = %q
+  manage_protocols_exclusively = %t
+  protocols = [
+    { name = "ssh", port = 22 },
+  ]
+}
+`, comment, exclusive)
+}
+
+func TestAccAssetHostResource_createReturnsOK(t *testing.T) {
+	for name, withBody := range map[string]bool{"with body": true, "without body": false} {
+		t.Run(name, func(t *testing.T) {
+			server := newTestServer(t)
+			// Some JumpServer versions and proxies answer a create with 200,
+			// and some proxies drop the response body.
+			server.handle(http.MethodPost, testHostsPath, func(w http.ResponseWriter, r *http.Request) {
+				var host map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&host); err != nil {
+					writeTestJSON(w, http.StatusBadRequest, map[string]interface{}{"detail": err.Error()})
+					return
+				}
+				id := server.put(testHostsPath, host)
+				if !withBody {
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+				created, _ := server.object(testHostsPath, id)
+				writeTestJSON(w, http.StatusOK, created)
+			})
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccProviderConfig(server) + testAccAssetHostConfig("10.0.0.5", "web server"),
+						Check: func(s *terraform.State) error {
+							hosts := server.list(testHostsPath)
+							if len(hosts) != 1 {
+								return fmt.Errorf("%d hosts on the server, want 1", len(hosts))
+							}
+							if id := s.RootModule().Resources["jumpserver_asset_host.test"].Primary.ID; id != fmt.Sprint(hosts[0]["id"]) {
+								return fmt.Errorf("id = %q, want the created host %v", id, hosts[0]["id"])
+							}
+							return nil
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccAssetHostResource_createStatusForVersion(t *testing.T) {
+	server := newTestServer(t)
+	server.handle(http.MethodGet, "/api/health/", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, http.StatusOK, map[string]interface{}{"status": true, "version": "v3.10.7"})
+	})
+	// A proxy in front of a v3 server turns the 201 into a 200 and rewrites
+	// the body, so the ID in it cannot be trusted.
+	server.handle(http.MethodPost, testHostsPath, func(w http.ResponseWriter, r *http.Request) {
+		var host map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&host); err != nil {
+			writeTestJSON(w, http.StatusBadRequest, map[string]interface{}{"detail": err.Error()})
+			return
+		}
+		server.put(testHostsPath, host)
+		writeTestJSON(w, http.StatusOK, map[string]interface{}{"id": "proxy-request-id"})
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccAssetHostConfig("10.0.0.5", "web server"),
+				Check: func(s *terraform.State) error {
+					hosts := server.list(testHostsPath)
+					if len(hosts) != 1 {
+						return fmt.Errorf("%d hosts on the server, want 1", len(hosts))
+					}
+					if id := s.RootModule().Resources["jumpserver_asset_host.test"].Primary.ID; id != fmt.Sprint(hosts[0]["id"]) {
+						return fmt.Errorf("id = %q, want the created host %v", id, hosts[0]["id"])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestDedupeNodes(t *testing.T) {
+	unique, duplicates := dedupeNodes([]interface{}{"/Default/web", "/Default", "/Default/web", 42, "/Default/web"})
+	if got, want := fmt.Sprint(unique), "[/Default/web /Default]"; got != want {
+		t.Errorf("unique = %s, want %s", got, want)
+	}
+	if got, want := fmt.Sprint(duplicates), "[/Default/web /Default/web]"; got != want {
+		t.Errorf("duplicates = %s, want %s", got, want)
+	}
+}
+
+func TestAccAssetHostResource_duplicateNodes(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccAssetHostConfig("10.0.0.5", "web server"),
+			},
+			{
+				// A node returned twice is read once, so there is no diff.
+				PreConfig: func() {
+					for _, host := range server.list(testHostsPath) {
+						server.update(testHostsPath, fmt.Sprint(host["id"]), map[string]interface{}{
+							"nodes_display": []string{"/Default", "/Default"},
+						})
+					}
+				},
+				Config:   testAccProviderConfig(server) + testAccAssetHostConfig("10.0.0.5", "web server"),
+				PlanOnly: true,
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "nodes_display.#", "1"),
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "nodes_display.0", "/Default"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAssetHostResource_malformedProtocols(t *testing.T) {
+	server := newTestServer(t)
+	setProtocols := func(protocols interface{}) func() {
+		return func() {
+			for _, host := range server.list(testHostsPath) {
+				server.update(testHostsPath, fmt.Sprint(host["id"]), map[string]interface{}{"protocols": protocols})
+			}
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccAssetHostConfig("10.0.0.5", "web server"),
+			},
+			{
+				// Protocols returned as an object are reported instead of panicking.
+				PreConfig:    setProtocols(map[string]interface{}{"name": "ssh", "port": 22}),
+				RefreshState: true,
+				ExpectError:  regexp.MustCompile(`Expected "protocols" to be an array`),
+			},
+			{
+				// Restore the protocols so the host can be destroyed.
+				PreConfig: setProtocols([]interface{}{map[string]interface{}{"name": "ssh", "port": 22}}),
+				Config:    testAccProviderConfig(server) + testAccAssetHostConfig("10.0.0.5", "web server"),
+			},
+		},
+	})
+}
+
+func TestDecodeListField(t *testing.T) {
+	var diags diag.Diagnostics
+	if _, ok := decodeListField(&diags, map[string]interface{}{"protocols": map[string]interface{}{"name": "ssh"}}, "protocols"); ok || !diags.HasError() {
+		t.Fatalf("decodeListField accepted an object, diagnostics = %v", diags)
+	}
+	diags = nil
+	if _, ok := decodeListField(&diags, map[string]interface{}{"protocols": nil}, "protocols"); ok || diags.HasError() {
+		t.Fatalf("decodeListField(null) = %v, diagnostics = %v, want not ok without errors", ok, diags)
+	}
+}
+
+func TestAccAssetHostResource_importExisting(t *testing.T) {
+	server := newTestServer(t)
+	// A host created in JumpServer, as the API returns it.
+	id := server.put(testHostsPath, map[string]interface{}{
+		"name":          "legacy-01",
+		"address":       "10.0.1.20",
+		"platform":      map[string]interface{}{"id": 1, "name": "Linux"},
+		"nodes_display": []string{"/Default/Legacy"},
+		"protocols":     []interface{}{map[string]interface{}{"name": "ssh", "port": 2222}},
+		"is_active":     true,
+		"comment":       "created by hand",
+		"labels":        []interface{}{},
+		"date_created":  "2024/03/01 08:00:00 +0800",
+		"created_by":    "Administrator",
+	})
+	// The host's platform is read back as its ID, so the configuration uses the ID.
+	config := testAccProviderConfig(server) + fmt.Sprintf(`
+resource "jumpserver_asset_host" "legacy" {
+  name          = "legacy-01"
+  ip            = "10.0.1.20"
+  platform      = %q
+  nodes_display = ["/Default/Legacy"]
+  comment       = "created by hand"
+  protocols = [
+    { name = "ssh", port = 2222 },
+  ]
+}
+`, testPlatformLinux)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             config,
+				ResourceName:       "jumpserver_asset_host.legacy",
+				ImportState:        true,
+				ImportStateId:      id,
+				ImportStatePersist: true,
+			},
+			{
+				// The imported host matches the configuration.
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestSetHostRegion(t *testing.T) {
+	tests := []struct {
+		name   string
+		asset  map[string]interface{}
+		region types.String
+		want   string
+	}{
+		{"create", map[string]interface{}{}, types.StringValue("cn-north-1"), "map[region:cn-north-1]"},
+		{"create without region", map[string]interface{}{}, types.StringNull(), "<nil>"},
+		{"keeps other fields", map[string]interface{}{"custom_info": map[string]interface{}{"owner": "ops", "region": "cn-north-1"}}, types.StringValue("cn-east-2"), "map[owner:ops region:cn-east-2]"},
+		{"unset", map[string]interface{}{"custom_info": map[string]interface{}{"owner": "ops", "region": "cn-north-1"}}, types.StringNull(), "map[owner:ops]"},
+		{"unset without region", map[string]interface{}{"custom_info": map[string]interface{}{"owner": "ops"}}, types.StringNull(), "map[owner:ops]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setHostRegion(tt.asset, tt.region)
+			if got := fmt.Sprint(tt.asset["custom_info"]); got != tt.want {
+				t.Errorf("custom_info = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckRegionPlatform(t *testing.T) {
+	server := newTestServer(t)
+	server.put("/api/v1/assets/platforms/", map[string]interface{}{
+		"id":       2,
+		"name":     "AWS",
+		"category": map[string]interface{}{"value": "cloud", "label": "Cloud"},
+		"type":     map[string]interface{}{"value": "public", "label": "Public cloud"},
+	})
+	r := &assetHostResource{client: newTestClient(server)}
+
+	for platform, wantWarning := range map[string]bool{"Linux": true, "AWS": false} {
+		var resp fwresource.ModifyPlanResponse
+		r.checkRegionPlatform(context.Background(), JumpServerHostResourceModel{Platform: types.StringValue(platform)}, &resp)
+		if got := resp.Diagnostics.WarningsCount() > 0; got != wantWarning {
+			t.Errorf("platform %s: warning = %v, want %v (diagnostics %v)", platform, got, wantWarning, resp.Diagnostics)
+		}
+	}
+}
+
+func TestAccAssetHostResource_categoryProtocols(t *testing.T) {
+	server := newTestServer(t)
+	config := func(protocols string) string {
+		return testAccProviderConfig(server) + `
+resource "jumpserver_asset_host" "test" {
+  name          = "web-01"
+  ip            = "10.0.0.5"
+  platform      = "Linux"
+  nodes_display = ["/Default"]
+` + protocols + `
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// mysql is a known protocol, but not one host platforms accept.
+				Config:      config(`  protocols = [{ name = "ssh" }, { name = "mysql" }]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Protocol "mysql" is not supported by host platforms`),
+			},
+			{
+				Config:      config(`  protocols_simple = { rdp = 3389, redis = 6379 }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Protocol "redis" is not supported by host platforms`),
+			},
+			{
+				Config: config(`  protocols = [{ name = "ssh" }, { name = "rdp" }]`),
+				Check:  resource.TestCheckResourceAttr("jumpserver_asset_host.test", "protocols.#", "2"),
+			},
+		},
+	})
+}
+
+func TestAccAssetHostResource_region(t *testing.T) {
+	server := newTestServer(t)
+	customInfo := func(want string) resource.TestCheckFunc {
+		return testAccCheckHostField(server, "jumpserver_asset_host.test", "custom_info", want)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccAssetHostRegionConfig("cn-north-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "region", "cn-north-1"),
+					customInfo("map[region:cn-north-1]"),
+				),
+			},
+			{
+				// Changing the region keeps custom fields set in JumpServer.
+				PreConfig: func() {
+					for _, host := range server.list(testHostsPath) {
+						server.update(testHostsPath, fmt.Sprint(host["id"]), map[string]interface{}{
+							"custom_info": map[string]interface{}{"owner": "ops", "region": "cn-north-1"},
+						})
+					}
+				},
+				Config: testAccProviderConfig(server) + testAccAssetHostRegionConfig("cn-east-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "region", "cn-east-2"),
+					customInfo("map[owner:ops region:cn-east-2]"),
+				),
+			},
+			{
+				// Removing region from the configuration clears it in JumpServer.
+				Config: testAccProviderConfig(server) + testAccAssetHostRegionConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("jumpserver_asset_host.test", "region"),
+					customInfo("map[owner:ops]"),
+				),
+			},
+		},
+	})
+}
+
+// testAccAssetHostRegionConfig returns a host with region, or without one
+// when region is empty.
+func testAccAssetHostRegionConfig(region string) string {
+	regionLine := ""
+	if region != "" {
+		regionLine = fmt.Sprintf("region        = %q", region)
+	}
+	return fmt.Sprintf(`
+resource "jumpserver_asset_host" "test" {
+  name          = "web-01"
+  ip            = "10.0.0.5"
+  platform      = "Linux"
+  nodes_display = ["/Default"]
+  %s
+  protocols = [
+    { name = "ssh" },
+  ]
+}
+`, regionLine)
+}
+
+// testChangeSecretRecordsPath is the collection holding per-account push
+// results.
+const testChangeSecretRecordsPath = "/api/v1/accounts/change-secret-records/"
+
+// handleHostAccounts makes the test server create the inline accounts of a
+// host in the account collection. Verifying or pushing an account succeeds
+// when its secret is "valid".
+func handleHostAccounts(server *testServer) {
+	server.handle(http.MethodPost, testHostsPath, func(w http.ResponseWriter, r *http.Request) {
+		var host map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&host); err != nil {
+			writeTestJSON(w, http.StatusBadRequest, map[string]interface{}{"detail": err.Error()})
+			return
+		}
+		accounts, _ := host["accounts"].([]interface{})
+		delete(host, "accounts")
+		id := server.put(testHostsPath, host)
+		for _, item := range accounts {
+			account, _ := item.(map[string]interface{})
+			account["asset"] = map[string]interface{}{"id": id, "name": host["name"]}
+			account["connectivity"] = "-"
+			server.put(testAccountsPath, account)
+		}
+		created, _ := server.object(testHostsPath, id)
+		writeTestJSON(w, http.StatusCreated, created)
+	})
+	server.handle(http.MethodPost, testAccountsPath+"tasks/", func(w http.ResponseWriter, r *http.Request) {
+		var task struct {
+			Action   string   `json:"action"`
+			Accounts []string `json:"accounts"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&task); err != nil {
+			writeTestJSON(w, http.StatusBadRequest, map[string]interface{}{"detail": err.Error()})
+			return
+		}
+		switch task.Action {
+		case "verify":
+			for _, id := range task.Accounts {
+				account, _ := server.object(testAccountsPath, id)
+				connectivity := "err"
+				if account["secret"] == "valid" {
+					connectivity = "ok"
+				}
+				server.update(testAccountsPath, id, map[string]interface{}{"connectivity": connectivity})
+			}
+			writeTestJSON(w, http.StatusCreated, map[string]interface{}{"task": "verify-accounts"})
+		case "push":
+			for _, id := range task.Accounts {
+				account, _ := server.object(testAccountsPath, id)
+				record := map[string]interface{}{
+					"execution": "push-accounts",
+					"asset":     account["asset"],
+					"account":   map[string]interface{}{"id": id, "name": account["name"]},
+					"status":    map[string]interface{}{"value": "success", "label": "Success"},
+					"error":     "",
+				}
+				if account["secret"] != "valid" {
+					record["status"] = map[string]interface{}{"value": "failed", "label": "Failed"}
+					record["error"] = "Authentication failed"
+				}
+				server.put(testChangeSecretRecordsPath, record)
+			}
+			writeTestJSON(w, http.StatusCreated, map[string]interface{}{"task": "push-accounts"})
+		default:
+			writeTestJSON(w, http.StatusBadRequest, map[string]interface{}{"detail": "unexpected task " + task.Action})
+		}
+	})
+	handleTask(server, "verify-accounts", "PENDING", "SUCCESS")
+	handleTask(server, "push-accounts", "SUCCESS")
+}
+
+func TestAccAssetHostResource_verifyAfterPush(t *testing.T) {
+	server := newTestServer(t)
+	handleHostAccounts(server)
+	config := func(verify bool) string {
+		return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "jumpserver_asset_host" "test" {
+  name              = "web-01"
+  ip                = "10.0.0.5"
+  platform          = "Linux"
+  nodes_display     = ["/Default"]
+  push_accounts     = true
+  verify_after_push = %t
+  protocols = [
+    { name = "ssh" },
+  ]
+  accounts = [
+    { name = "root", username = "root", secret = "valid" },
+    { name = "backup", username = "backup", secret = "stale" },
+  ]
+}
+`, verify)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "accounts.0.verified", "true"),
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "accounts.1.verified", "false"),
+				),
+			},
+			{
+				// Turning verification off keeps the recorded results and does not replace the host.
+				Config: config(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "accounts.0.verified", "true"),
+					func(_ *terraform.State) error {
+						// One push and one verification, both at create.
+						if got := len(server.requestsTo(http.MethodPost, testAccountsPath+"tasks/")); got != 2 {
+							return fmt.Errorf("%d account tasks, want 2", got)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccAssetHostResource_accountsWithoutVerification(t *testing.T) {
+	server := newTestServer(t)
+	handleHostAccounts(server)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "jumpserver_asset_host" "test" {
+  name          = "web-01"
+  ip            = "10.0.0.5"
+  platform      = "Linux"
+  nodes_display = ["/Default"]
+  protocols = [
+    { name = "ssh" },
+  ]
+  accounts = [
+    { name = "root", username = "root", secret = "valid" },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpserver_asset_host.test", "accounts.#", "1"),
+					resource.TestCheckNoResourceAttr("jumpserver_asset_host.test", "accounts.0.verified"),
+					func(_ *terraform.State) error {
+						if got := len(server.requestsTo(http.MethodPost, testAccountsPath+"tasks/")); got != 0 {
+							return fmt.Errorf("%d verification tasks, want none", got)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAssetHostResource_pushAccounts(t *testing.T) {
+	server := newTestServer(t)
+	handleHostAccounts(server)
+	hostID := server.put(testHostsPath, map[string]interface{}{"name": "web-01", "address": "10.0.0.5"})
+	server.put(testAccountsPath, map[string]interface{}{
+		"name": "root", "username": "root", "secret": "valid", "asset": map[string]interface{}{"id": hostID},
+	})
+	server.put(testAccountsPath, map[string]interface{}{
+		"name": "backup", "username": "backup", "secret": "stale", "asset": map[string]interface{}{"id": hostID},
+	})
+
+	r := &assetHostResource{client: newTestClient(server)}
+	accounts, diags := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: hostAccountAttrTypes}, []HostAccountModel{
+		{Name: types.StringValue("root"), Username: types.StringValue("root")},
+		{Name: types.StringValue("backup"), Username: types.StringValue("backup")},
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	plan := JumpServerHostResourceModel{
+		ID:           types.StringValue(hostID),
+		Name:         types.StringValue("web-01"),
+		PushAccounts: types.BoolValue(true),
+		Accounts:     accounts,
+	}
+
+	diags = r.pushAccounts(context.Background(), plan, client.WithOrg(""))
+	if diags.HasError() {
+		t.Fatalf("pushing accounts: %v", diags)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("got %d warnings, want one for the failed account: %v", diags.WarningsCount(), diags)
+	}
+	warning := diags.Warnings()[0]
+	if got, want := warning.(diag.DiagnosticWithPath).Path(), path.Root("accounts").AtListIndex(1); !got.Equal(want) {
+		t.Errorf("warning path = %s, want %s", got, want)
+	}
+	for _, want := range []string{`"backup"`, "web-01", "Authentication failed"} {
+		if !strings.Contains(warning.Detail(), want) {
+			t.Errorf("warning %q does not mention %s", warning.Detail(), want)
+		}
+	}
+
+	plan.PushAccounts = types.BoolValue(false)
+	if diags := r.pushAccounts(context.Background(), plan, client.WithOrg("")); diags.HasError() || diags.WarningsCount() != 0 {
+		t.Errorf("push_accounts off: got diagnostics %v", diags)
+	}
+	if got := len(server.requestsTo(http.MethodPost, testAccountsPath+"tasks/")); got != 1 {
+		t.Errorf("%d push tasks, want 1", got)
+	}
+}
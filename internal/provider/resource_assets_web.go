@@ -0,0 +1,368 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+var _ resource.Resource = &assetWebResource{}
+var _ resource.ResourceWithImportState = &assetWebResource{}
+
+// 资源结构体
+type assetWebResource struct {
+	client *jumpServerClient
+}
+
+func AssetWebResource() resource.Resource {
+	return &assetWebResource{}
+}
+
+type JumpServerWebResourceModel struct {
+	ID               types.String `tfsdk:"id"`                // 只读
+	Name             types.String `tfsdk:"name"`              // 必填
+	Address          types.String `tfsdk:"address"`           // 必填，URL
+	Platform         types.String `tfsdk:"platform"`          // 必填
+	NodesDisplay     types.List   `tfsdk:"nodes_display"`     // 必填
+	Autofill         types.String `tfsdk:"autofill"`          // 可选，默认 no
+	UsernameSelector types.String `tfsdk:"username_selector"` // autofill 为 basic 时必填
+	PasswordSelector types.String `tfsdk:"password_selector"` // autofill 为 basic 时必填
+	SubmitSelector   types.String `tfsdk:"submit_selector"`   // autofill 为 basic 时必填
+}
+
+// 自动填充方式
+const (
+	webAutofillNo     = "no"
+	webAutofillBasic  = "basic"
+	webAutofillScript = "script"
+)
+
+// 仅在 autofill 为 basic 时使用的选择器属性
+var webSelectorAttributes = []string{"username_selector", "password_selector", "submit_selector"}
+
+// API 字段对应的属性，用于按字段报告校验错误
+var webFieldPaths = map[string]path.Path{
+	"name":              path.Root("name"),
+	"address":           path.Root("address"),
+	"platform":          path.Root("platform"),
+	"nodes":             path.Root("nodes_display"),
+	"autofill":          path.Root("autofill"),
+	"username_selector": path.Root("username_selector"),
+	"password_selector": path.Root("password_selector"),
+	"submit_selector":   path.Root("submit_selector"),
+}
+
+func (r *assetWebResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_web"
+}
+
+func (r *assetWebResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *assetWebResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the web asset. Import with `terraform import jumpserver_asset_web.example <uuid>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the web asset",
+			},
+			"address": schema.StringAttribute{
+				Required:    true,
+				Description: "The URL of the web application, e.g. `https://app.example.com/login`",
+				Validators: []validator.String{
+					webAddressValidator{},
+				},
+			},
+			"platform": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID or name of the platform of the web asset, usually `Website`",
+			},
+			"nodes_display": schema.ListAttribute{
+				Required:    true,
+				Description: "The nodes display of the web asset",
+				ElementType: types.StringType,
+			},
+			"autofill": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(webAutofillNo),
+				Description: "How credentials are filled in on the login page: `no`, `basic` using the selectors below, or `script`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(webAutofillNo, webAutofillBasic, webAutofillScript),
+				},
+			},
+			"username_selector": schema.StringAttribute{
+				Optional:    true,
+				Description: "The CSS selector of the username input. Required when `autofill` is `basic`",
+			},
+			"password_selector": schema.StringAttribute{
+				Optional:    true,
+				Description: "The CSS selector of the password input. Required when `autofill` is `basic`",
+			},
+			"submit_selector": schema.StringAttribute{
+				Optional:    true,
+				Description: "The CSS selector of the submit button. Required when `autofill` is `basic`",
+			},
+		},
+	}
+}
+
+// 配置校验：选择器仅在 basic 自动填充时使用
+func (r *assetWebResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		webAutofillValidator{},
+	}
+}
+
+var _ resource.ConfigValidator = webAutofillValidator{}
+
+// webAutofillValidator 要求 basic 自动填充设置全部选择器，其他方式不设置选择器
+type webAutofillValidator struct{}
+
+func (v webAutofillValidator) Description(_ context.Context) string {
+	return "the selectors must be set when autofill is basic and only then"
+}
+
+func (v webAutofillValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v webAutofillValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var autofill types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("autofill"), &autofill)...)
+	if resp.Diagnostics.HasError() || autofill.IsUnknown() {
+		return
+	}
+
+	for _, attribute := range webSelectorAttributes {
+		var selector types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &selector)...)
+		if resp.Diagnostics.HasError() || selector.IsUnknown() {
+			return
+		}
+
+		switch {
+		case autofill.ValueString() == webAutofillBasic && selector.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Missing Autofill Selector",
+				fmt.Sprintf("%s must be set when autofill is %q.", attribute, webAutofillBasic),
+			)
+		case autofill.ValueString() != webAutofillBasic && !selector.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Unused Autofill Selector",
+				fmt.Sprintf("%s is only used when autofill is %q.", attribute, webAutofillBasic),
+			)
+		}
+	}
+}
+
+// 由计划构造创建/更新请求体
+func buildWebPayload(ctx context.Context, plan JumpServerWebResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var nodesDisplay []string
+	diags.Append(plan.NodesDisplay.ElementsAs(ctx, &nodesDisplay, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	asset := map[string]interface{}{
+		"name":              plan.Name.ValueString(),
+		"address":           plan.Address.ValueString(),
+		"platform":          plan.Platform.ValueString(),
+		"nodes_display":     nodesDisplay,
+		"autofill":          plan.Autofill.ValueString(),
+		"username_selector": plan.UsernameSelector.ValueString(),
+		"password_selector": plan.PasswordSelector.ValueString(),
+		"submit_selector":   plan.SubmitSelector.ValueString(),
+		"is_active":         true,
+	}
+	return asset, diags
+}
+
+// 创建资源
+func (r *assetWebResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerWebResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	asset, diags := buildWebPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if asset["platform"], diags = resolvePayloadPlatform(ctx, r.client, plan.Platform); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	var result map[string]interface{}
+	if err := r.client.api.Post(ctx, "/api/v1/assets/webs/", asset, &result); err != nil {
+		addAPIError(&resp.Diagnostics, err, "create web assets", "asset management", webFieldPaths)
+		return
+	}
+	id := stringField(result, "id")
+	if id == "" {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve web asset ID from response")
+		return
+	}
+	plan.ID = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 读取资源
+func (r *assetWebResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerWebResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/assets/webs/%s/", state.ID.ValueString()), nil, &result)
+	// 资产已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read web assets", "asset view", nil)
+		return
+	}
+
+	if name, ok := decodeStringField(&resp.Diagnostics, result, "name"); ok {
+		state.Name = types.StringValue(name)
+	}
+	if address, ok := decodeStringField(&resp.Diagnostics, result, "address"); ok {
+		state.Address = types.StringValue(address)
+	}
+	if platform, ok := decodePlatformField(&resp.Diagnostics, result); ok {
+		state.Platform = platformStateValue(ctx, r.client, state.Platform, platform)
+	}
+	// 自动填充方式可能返回字符串，也可能返回 {"value": ..., "label": ...} 对象
+	switch autofill := result["autofill"].(type) {
+	case string:
+		state.Autofill = types.StringValue(autofill)
+	case map[string]interface{}:
+		if value := stringField(autofill, "value"); value != "" {
+			state.Autofill = types.StringValue(value)
+		}
+	}
+	// 选择器：状态为 null 且服务端为空时保持 null
+	state.UsernameSelector = flattenOptionalString(state.UsernameSelector, stringField(result, "username_selector"))
+	state.PasswordSelector = flattenOptionalString(state.PasswordSelector, stringField(result, "password_selector"))
+	state.SubmitSelector = flattenOptionalString(state.SubmitSelector, stringField(result, "submit_selector"))
+
+	nodes, nodesOk := decodeListField(&resp.Diagnostics, result, "nodes_display")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if nodesOk {
+		unique, _ := dedupeNodes(nodes)
+		nodesList, diags := types.ListValueFrom(ctx, types.StringType, unique)
+		resp.Diagnostics.Append(diags...)
+		state.NodesDisplay = nodesList
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// 导入资源：按 UUID 导入，其余属性由 Read 填充
+func (r *assetWebResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// 可选字符串：状态为 null 且服务端为空字符串时保持 null，避免产生差异
+func flattenOptionalString(prior types.String, value string) types.String {
+	if value == "" && prior.IsNull() {
+		return prior
+	}
+	return types.StringValue(value)
+}
+
+// 更新资源
+func (r *assetWebResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerWebResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+
+	asset, diags := buildWebPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if asset["platform"], diags = resolvePayloadPlatform(ctx, r.client, plan.Platform); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/assets/webs/%s/", state.ID.ValueString())
+	if err := r.client.api.Patch(ctx, apiPath, asset, nil); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update web assets", "asset management", webFieldPaths)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 删除资源
+func (r *assetWebResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerWebResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/assets/webs/%s/", state.ID.ValueString())
+	if err := r.client.api.Delete(ctx, apiPath, nil, nil); err != nil && !client.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, err, "delete web assets", "asset management", nil)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+var _ resource.Resource = &commandACLResource{}
+var _ resource.ResourceWithConfigValidators = &commandACLResource{}
+var _ resource.ResourceWithImportState = &commandACLResource{}
+
+// ACL 的动作；review 需要指定审批人
+const aclActionReview = "review"
+
+// 资源结构体
+type commandACLResource struct {
+	client *jumpServerClient
+}
+
+func CommandACLResource() resource.Resource {
+	return &commandACLResource{}
+}
+
+type JumpServerCommandACLResourceModel struct {
+	ID            types.String `tfsdk:"id"`             // 只读
+	Name          types.String `tfsdk:"name"`           // 必填
+	Priority      types.Int64  `tfsdk:"priority"`       // 可选，默认 50
+	Action        types.String `tfsdk:"action"`         // 必填
+	CommandGroups types.List   `tfsdk:"command_groups"` // 必填，命令组 ID
+	Users         types.List   `tfsdk:"users"`          // 可选，用户 ID，不设置时作用于所有用户
+	Assets        types.List   `tfsdk:"assets"`         // 可选，资产 ID，不设置时作用于所有资产
+	Accounts      types.List   `tfsdk:"accounts"`       // 可选，账号名，不设置时作用于所有账号
+	Reviewers     types.List   `tfsdk:"reviewers"`      // 可选，审批人用户 ID，action 为 review 时必填
+}
+
+// API 字段对应的属性，用于按字段报告校验错误
+var commandACLFieldPaths = rootFieldPaths("name", "priority", "action", "command_groups", "users", "assets", "accounts", "reviewers")
+
+// 命令过滤 ACL 接口返回的字段
+type apiCommandACL struct {
+	ID            string      `json:"id"`
+	Name          string      `json:"name"`
+	Priority      int64       `json:"priority"`
+	Action        choiceField `json:"action"`
+	CommandGroups []objectRef `json:"command_groups"`
+	Users         aclTarget   `json:"users"`
+	Assets        aclTarget   `json:"assets"`
+	Accounts      []string    `json:"accounts"`
+	Reviewers     []objectRef `json:"reviewers"`
+}
+
+// ACL 作用对象：新版本返回 {"type": "all"|"ids"|"attrs", "ids": [...]}，旧版本返回 ID 列表
+type aclTarget struct {
+	Type string
+	IDs  []string
+}
+
+func (t *aclTarget) UnmarshalJSON(data []byte) error {
+	var refs []objectRef
+	if err := json.Unmarshal(data, &refs); err == nil {
+		t.Type = "ids"
+		t.IDs = refIDs(refs)
+		return nil
+	}
+	var target struct {
+		Type string      `json:"type"`
+		IDs  []objectRef `json:"ids"`
+	}
+	if err := json.Unmarshal(data, &target); err != nil {
+		return err
+	}
+	t.Type = target.Type
+	t.IDs = refIDs(target.IDs)
+	return nil
+}
+
+// 由列表属性构造 ACL 作用对象，未设置时作用于全部
+func expandACLTarget(ctx context.Context, diags *diag.Diagnostics, list types.List) map[string]interface{} {
+	if list.IsNull() {
+		return map[string]interface{}{"type": "all"}
+	}
+	ids := []string{}
+	diags.Append(list.ElementsAs(ctx, &ids, false)...)
+	return map[string]interface{}{"type": "ids", "ids": ids}
+}
+
+// 刷新 ACL 作用对象：作用于全部且状态为 null 时保持 null
+func flattenACLTarget(ctx context.Context, diags *diag.Diagnostics, prior types.List, target aclTarget) types.List {
+	if target.Type == "all" && prior.IsNull() {
+		return prior
+	}
+	return refreshIDList(ctx, diags, prior, target.IDs)
+}
+
+// 转换为字符串列表，未设置时返回空列表
+func listStrings(ctx context.Context, diags *diag.Diagnostics, list types.List) []string {
+	values := []string{}
+	if !list.IsNull() {
+		diags.Append(list.ElementsAs(ctx, &values, false)...)
+	}
+	return values
+}
+
+func (r *commandACLResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_command_acl"
+}
+
+func (r *commandACLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *commandACLResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the command ACL. Import with `terraform import jumpserver_command_acl.example <uuid>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the command ACL",
+			},
+			"priority": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(50),
+				Description: "The priority of the ACL, from 1 to 100. ACLs with a lower value are matched first",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"action": schema.StringAttribute{
+				Required:    true,
+				Description: "What happens when a command matches: `reject`, `accept`, `review` or `warning`. `review` requires `reviewers`",
+				Validators: []validator.String{
+					stringvalidator.OneOf("reject", "accept", aclActionReview, "warning"),
+				},
+			},
+			"command_groups": schema.ListAttribute{
+				Required:    true,
+				Description: "The IDs of the command groups the ACL matches",
+				ElementType: types.StringType,
+				Validators:  uuidListValidators,
+			},
+			"users": schema.ListAttribute{
+				Optional:    true,
+				Description: "The IDs of the users the ACL applies to. When not set, the ACL applies to all users",
+				ElementType: types.StringType,
+				Validators:  uuidListValidators,
+			},
+			"assets": schema.ListAttribute{
+				Optional:    true,
+				Description: "The IDs of the assets the ACL applies to. When not set, the ACL applies to all assets",
+				ElementType: types.StringType,
+				Validators:  uuidListValidators,
+			},
+			"accounts": schema.ListAttribute{
+				Optional:    true,
+				Description: "The usernames of the accounts the ACL applies to. When not set, the ACL applies to all accounts",
+				ElementType: types.StringType,
+			},
+			"reviewers": schema.ListAttribute{
+				Optional:    true,
+				Description: "The IDs of the users who review matched commands. Required when `action` is `review`",
+				ElementType: types.StringType,
+				Validators:  uuidListValidators,
+			},
+		},
+	}
+}
+
+// 配置校验：action 为 review 时必须指定审批人
+func (r *commandACLResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		aclReviewersValidator{},
+	}
+}
+
+var _ resource.ConfigValidator = aclReviewersValidator{}
+
+// aclReviewersValidator 要求 action 为 review 的 ACL 至少指定一个审批人
+type aclReviewersValidator struct{}
+
+func (v aclReviewersValidator) Description(_ context.Context) string {
+	return "reviewers must be set when action is review"
+}
+
+func (v aclReviewersValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v aclReviewersValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var action types.String
+	var reviewers types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("action"), &action)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("reviewers"), &reviewers)...)
+	if resp.Diagnostics.HasError() || action.IsUnknown() || reviewers.IsUnknown() {
+		return
+	}
+
+	if action.ValueString() == aclActionReview && len(reviewers.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("reviewers"),
+			"Missing Reviewers",
+			"At least one reviewer must be set when action is \"review\".",
+		)
+	}
+}
+
+// 由计划构造创建/更新请求体
+func buildCommandACLPayload(ctx context.Context, plan JumpServerCommandACLResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	accounts := []string{"@ALL"}
+	if !plan.Accounts.IsNull() {
+		accounts = listStrings(ctx, &diags, plan.Accounts)
+	}
+
+	payload := map[string]interface{}{
+		"name":           plan.Name.ValueString(),
+		"priority":       plan.Priority.ValueInt64(),
+		"action":         plan.Action.ValueString(),
+		"command_groups": listStrings(ctx, &diags, plan.CommandGroups),
+		"users":          expandACLTarget(ctx, &diags, plan.Users),
+		"assets":         expandACLTarget(ctx, &diags, plan.Assets),
+		"accounts":       accounts,
+		"reviewers":      listStrings(ctx, &diags, plan.Reviewers),
+	}
+	return payload, diags
+}
+
+// 创建资源
+func (r *commandACLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerCommandACLResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := buildCommandACLPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var acl apiCommandACL
+	if err := r.client.api.Post(ctx, "/api/v1/acls/command-filter-acls/", payload, &acl); err != nil {
+		addAPIError(&resp.Diagnostics, err, "create command ACLs", "ACL management", commandACLFieldPaths)
+		return
+	}
+	if acl.ID == "" {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve command ACL ID from response")
+		return
+	}
+	plan.ID = types.StringValue(acl.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 读取资源
+func (r *commandACLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerCommandACLResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var acl apiCommandACL
+	err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/acls/command-filter-acls/%s/", state.ID.ValueString()), nil, &acl)
+	// ACL 已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read command ACLs", "ACL view", nil)
+		return
+	}
+
+	state.Name = types.StringValue(acl.Name)
+	state.Priority = types.Int64Value(acl.Priority)
+	state.Action = types.StringValue(acl.Action.Value)
+	state.CommandGroups = refreshIDList(ctx, &resp.Diagnostics, state.CommandGroups, refIDs(acl.CommandGroups))
+	state.Users = flattenACLTarget(ctx, &resp.Diagnostics, state.Users, acl.Users)
+	state.Assets = flattenACLTarget(ctx, &resp.Diagnostics, state.Assets, acl.Assets)
+	state.Reviewers = refreshIDList(ctx, &resp.Diagnostics, state.Reviewers, refIDs(acl.Reviewers))
+
+	// 未配置时发送 @ALL，状态为 null 时保持 null
+	if !state.Accounts.IsNull() {
+		state.Accounts = refreshIDList(ctx, &resp.Diagnostics, state.Accounts, acl.Accounts)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// 导入资源：按 UUID 导入，其余属性由 Read 填充
+func (r *commandACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// 更新资源
+func (r *commandACLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerCommandACLResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+
+	payload, diags := buildCommandACLPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/acls/command-filter-acls/%s/", state.ID.ValueString())
+	if err := r.client.api.Patch(ctx, apiPath, payload, nil); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update command ACLs", "ACL management", commandACLFieldPaths)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 删除资源
+func (r *commandACLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerCommandACLResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/acls/command-filter-acls/%s/", state.ID.ValueString())
+	if err := r.client.api.Delete(ctx, apiPath, nil, nil); err != nil && !client.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, err, "delete command ACLs", "ACL management", nil)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+var _ resource.Resource = &commandGroupResource{}
+var _ resource.ResourceWithImportState = &commandGroupResource{}
+
+// 资源结构体
+type commandGroupResource struct {
+	client *jumpServerClient
+}
+
+func CommandGroupResource() resource.Resource {
+	return &commandGroupResource{}
+}
+
+type JumpServerCommandGroupResourceModel struct {
+	ID         types.String `tfsdk:"id"`          // 只读
+	Name       types.String `tfsdk:"name"`        // 必填
+	Type       types.String `tfsdk:"type"`        // 可选，默认 command
+	Content    types.String `tfsdk:"content"`     // 必填，每行一条命令或正则
+	IgnoreCase types.Bool   `tfsdk:"ignore_case"` // 可选，默认 true
+}
+
+// API 字段对应的属性，用于按字段报告校验错误
+var commandGroupFieldPaths = rootFieldPaths("name", "type", "content", "ignore_case")
+
+// 命令组接口返回的字段
+type apiCommandGroup struct {
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	Type       choiceField `json:"type"`
+	Content    string      `json:"content"`
+	IgnoreCase bool        `json:"ignore_case"`
+}
+
+func (r *commandGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_command_group"
+}
+
+func (r *commandGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *commandGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the command group. Import with `terraform import jumpserver_command_group.example <uuid>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the command group",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("command"),
+				Description: "How `content` is matched: `command` matches command names, `regex` matches regular expressions",
+				Validators: []validator.String{
+					stringvalidator.OneOf("command", "regex"),
+				},
+			},
+			"content": schema.StringAttribute{
+				Required:    true,
+				Description: "The commands or regular expressions of the group, one per line",
+			},
+			"ignore_case": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether commands are matched case-insensitively",
+			},
+		},
+	}
+}
+
+// 由计划构造创建/更新请求体
+func buildCommandGroupPayload(plan JumpServerCommandGroupResourceModel) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        plan.Name.ValueString(),
+		"type":        plan.Type.ValueString(),
+		"content":     plan.Content.ValueString(),
+		"ignore_case": plan.IgnoreCase.ValueBool(),
+	}
+}
+
+// 创建资源
+func (r *commandGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerCommandGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var group apiCommandGroup
+	if err := r.client.api.Post(ctx, "/api/v1/acls/command-groups/", buildCommandGroupPayload(plan), &group); err != nil {
+		addAPIError(&resp.Diagnostics, err, "create command groups", "ACL management", commandGroupFieldPaths)
+		return
+	}
+	if group.ID == "" {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve command group ID from response")
+		return
+	}
+	plan.ID = types.StringValue(group.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 读取资源
+func (r *commandGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerCommandGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var group apiCommandGroup
+	err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/acls/command-groups/%s/", state.ID.ValueString()), nil, &group)
+	// 命令组已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read command groups", "ACL view", nil)
+		return
+	}
+	state.Name = types.StringValue(group.Name)
+	state.Type = types.StringValue(group.Type.Value)
+	state.Content = types.StringValue(group.Content)
+	state.IgnoreCase = types.BoolValue(group.IgnoreCase)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// 导入资源：按 UUID 导入，其余属性由 Read 填充
+func (r *commandGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// 更新资源
+func (r *commandGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerCommandGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+
+	apiPath := fmt.Sprintf("/api/v1/acls/command-groups/%s/", state.ID.ValueString())
+	if err := r.client.api.Patch(ctx, apiPath, buildCommandGroupPayload(plan), nil); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update command groups", "ACL management", commandGroupFieldPaths)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 删除资源
+func (r *commandGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerCommandGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/acls/command-groups/%s/", state.ID.ValueString())
+	if err := r.client.api.Delete(ctx, apiPath, nil, nil); err != nil && !client.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, err, "delete command groups", "ACL management", nil)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+var _ resource.Resource = &domainResource{}
+var _ resource.ResourceWithImportState = &domainResource{}
+
+// 资源结构体
+type domainResource struct {
+	client *jumpServerClient
+}
+
+func DomainResource() resource.Resource {
+	return &domainResource{}
+}
+
+type JumpServerDomainResourceModel struct {
+	ID      types.String `tfsdk:"id"`      // 只读
+	Name    types.String `tfsdk:"name"`    // 必填
+	Comment types.String `tfsdk:"comment"` // 可选
+}
+
+// API 字段对应的属性，用于按字段报告校验错误
+var domainFieldPaths = rootFieldPaths("name", "comment")
+
+// 网域接口返回的字段
+type apiDomain struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Comment string `json:"comment"`
+}
+
+func (r *domainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain"
+}
+
+func (r *domainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *domainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the domain. Import with `terraform import jumpserver_domain.example <uuid>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the domain, the network zone whose assets are reached through its gateways",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "A comment describing the domain",
+			},
+		},
+	}
+}
+
+// 创建资源
+func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := map[string]interface{}{
+		"name":    plan.Name.ValueString(),
+		"comment": plan.Comment.ValueString(),
+	}
+	var domain apiDomain
+	if err := r.client.api.Post(ctx, "/api/v1/assets/domains/", payload, &domain); err != nil {
+		addAPIError(&resp.Diagnostics, err, "create domains", "asset management", domainFieldPaths)
+		return
+	}
+	if domain.ID == "" {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve domain ID from response")
+		return
+	}
+	plan.ID = types.StringValue(domain.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 读取资源
+func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var domain apiDomain
+	err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/assets/domains/%s/", state.ID.ValueString()), nil, &domain)
+	// 网域已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read domains", "asset view", nil)
+		return
+	}
+	state.Name = types.StringValue(domain.Name)
+	state.Comment = types.StringValue(domain.Comment)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// 导入资源：按 UUID 导入，其余属性由 Read 填充
+func (r *domainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// 更新资源
+func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+
+	payload := map[string]interface{}{
+		"name":    plan.Name.ValueString(),
+		"comment": plan.Comment.ValueString(),
+	}
+	apiPath := fmt.Sprintf("/api/v1/assets/domains/%s/", state.ID.ValueString())
+	if err := r.client.api.Patch(ctx, apiPath, payload, nil); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update domains", "asset management", domainFieldPaths)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 删除资源
+func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/assets/domains/%s/", state.ID.ValueString())
+	err := r.client.api.Delete(ctx, apiPath, nil, nil)
+	// 网域仍被资产或网关引用时 JumpServer 拒绝删除
+	var apiErr *client.Error
+	if errors.As(err, &apiErr) && apiErr.StatusCode() == http.StatusBadRequest {
+		resp.Diagnostics.AddError(
+			"Domain In Use",
+			fmt.Sprintf("JumpServer refused to delete domain %s, most likely because assets or gateways still belong to it. "+
+				"Move or delete those assets and gateways first. Response: %s", state.ID.ValueString(), string(apiErr.Body)),
+		)
+		return
+	}
+	if err != nil && !client.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, err, "delete domains", "asset management", nil)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
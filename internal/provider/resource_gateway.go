@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+var _ resource.Resource = &gatewayResource{}
+var _ resource.ResourceWithImportState = &gatewayResource{}
+var _ resource.ResourceWithModifyPlan = &gatewayResource{}
+
+// 资源结构体
+type gatewayResource struct {
+	client *jumpServerClient
+}
+
+func GatewayResource() resource.Resource {
+	return &gatewayResource{}
+}
+
+type JumpServerGatewayResourceModel struct {
+	ID        types.String `tfsdk:"id"`        // 只读
+	Name      types.String `tfsdk:"name"`      // 必填
+	Address   types.String `tfsdk:"address"`   // 必填
+	Domain    types.String `tfsdk:"domain"`    // 必填，网域 ID
+	Protocols types.List   `tfsdk:"protocols"` // 必填
+}
+
+// API 字段对应的属性，用于按字段报告校验错误
+var gatewayFieldPaths = rootFieldPaths("name", "address", "domain", "protocols")
+
+func (r *gatewayResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway"
+}
+
+func (r *gatewayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *gatewayResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the gateway. Import with `terraform import jumpserver_gateway.example <uuid>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the gateway",
+			},
+			"address": schema.StringAttribute{
+				Required:    true,
+				Description: "The IP address or hostname of the gateway",
+				Validators: []validator.String{
+					hostAddressValidator{},
+				},
+			},
+			"domain": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the domain the gateway belongs to",
+				Validators:  uuidValidators,
+			},
+			"protocols": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The protocols of the gateway, usually `ssh`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The protocol name. Any case is accepted; it is sent to JumpServer in lowercase",
+						},
+						"port": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The port of the protocol, between 1 and 65535",
+							Validators:  protocolPortValidators,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// 计划阶段：未启用按平台校验时，按内置协议表校验协议名称
+func (r *gatewayResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() || r.client.validateProtocolsFromAPI {
+		return
+	}
+
+	var plan JumpServerGatewayResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	addUnknownProtocolErrors(&resp.Diagnostics, plan.Protocols)
+}
+
+// 创建资源
+func (r *gatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerGatewayResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gateway, diags := buildGatewayPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	if err := r.client.api.Post(ctx, "/api/v1/assets/gateways/", gateway, &result); err != nil {
+		addAPIError(&resp.Diagnostics, err, "create gateways", "asset management", gatewayFieldPaths)
+		return
+	}
+	id, ok := result["id"].(string)
+	if !ok {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve gateway ID from response")
+		return
+	}
+	plan.ID = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 由计划构造创建/更新请求体
+func buildGatewayPayload(ctx context.Context, plan JumpServerGatewayResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// 网域 ID 格式已由 schema 校验
+	domain := plan.Domain.ValueString()
+
+	var protocols []ProtocolModel
+	diags.Append(plan.Protocols.ElementsAs(ctx, &protocols, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	gateway := map[string]interface{}{
+		"name":      plan.Name.ValueString(),
+		"address":   plan.Address.ValueString(),
+		"domain":    domain,
+		"protocols": expandProtocolModels(protocols),
+		"is_active": true,
+	}
+	return gateway, diags
+}
+
+// 读取资源
+func (r *gatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerGatewayResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/assets/gateways/%s/", state.ID.ValueString()), nil, &result)
+	// 网关已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read gateways", "asset view", nil)
+		return
+	}
+
+	if name, ok := decodeStringField(&resp.Diagnostics, result, "name"); ok {
+		state.Name = types.StringValue(name)
+	}
+	if address, ok := decodeStringField(&resp.Diagnostics, result, "address"); ok {
+		state.Address = types.StringValue(address)
+	}
+	// 网域可能返回 ID 字符串，也可能返回 {"id": ..., "name": ...} 对象
+	if raw, ok := result["domain"]; ok && raw != nil {
+		var domain objectRef
+		if data, err := json.Marshal(raw); err == nil && json.Unmarshal(data, &domain) == nil {
+			state.Domain = types.StringValue(domain.ID)
+		}
+	}
+	serverProtocols, protocolsOk := decodeListField(&resp.Diagnostics, result, "protocols")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if protocolsOk {
+		var prior []ProtocolModel
+		if !state.Protocols.IsNull() && !state.Protocols.IsUnknown() {
+			resp.Diagnostics.Append(state.Protocols.ElementsAs(ctx, &prior, false)...)
+		}
+		protocolsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: protocolAttrTypes}, flattenHostProtocols(prior, serverProtocols))
+		resp.Diagnostics.Append(diags...)
+		state.Protocols = protocolsList
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// 导入资源：按 UUID 导入，其余属性由 Read 填充
+func (r *gatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// 更新资源
+func (r *gatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerGatewayResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+
+	gateway, diags := buildGatewayPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/assets/gateways/%s/", state.ID.ValueString())
+	if err := r.client.api.Patch(ctx, apiPath, gateway, nil); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update gateways", "asset management", gatewayFieldPaths)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 删除资源
+func (r *gatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerGatewayResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/assets/gateways/%s/", state.ID.ValueString())
+	if err := r.client.api.Delete(ctx, apiPath, nil, nil); err != nil && !client.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, err, "delete gateways", "asset management", nil)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+var _ resource.Resource = &labelResource{}
+var _ resource.ResourceWithImportState = &labelResource{}
+
+// 资源结构体
+type labelResource struct {
+	client *jumpServerClient
+}
+
+func LabelResource() resource.Resource {
+	return &labelResource{}
+}
+
+type JumpServerLabelResourceModel struct {
+	ID    types.String `tfsdk:"id"`    // 只读
+	Name  types.String `tfsdk:"name"`  // 必填
+	Value types.String `tfsdk:"value"` // 必填
+}
+
+// API 字段对应的属性，用于按字段报告校验错误
+var labelFieldPaths = rootFieldPaths("name", "value")
+
+// 标签接口返回的字段
+type apiLabel struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func (r *labelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_label"
+}
+
+func (r *labelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *labelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the label. Import with `terraform import jumpserver_label.example <uuid>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name (key) of the label",
+			},
+			"value": schema.StringAttribute{
+				Required:    true,
+				Description: "The value of the label. Labels are key/value pairs, so the same name can be used with several values",
+			},
+		},
+	}
+}
+
+// 创建资源
+func (r *labelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerLabelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := map[string]interface{}{
+		"name":  plan.Name.ValueString(),
+		"value": plan.Value.ValueString(),
+	}
+	var label apiLabel
+	if err := r.client.api.Post(ctx, "/api/v1/labels/labels/", payload, &label); err != nil {
+		addAPIError(&resp.Diagnostics, err, "create labels", "label management", labelFieldPaths)
+		return
+	}
+	if label.ID == "" {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve label ID from response")
+		return
+	}
+	plan.ID = types.StringValue(label.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 读取资源
+func (r *labelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerLabelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var label apiLabel
+	err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/labels/labels/%s/", state.ID.ValueString()), nil, &label)
+	// 标签已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read labels", "label view", nil)
+		return
+	}
+	state.Name = types.StringValue(label.Name)
+	state.Value = types.StringValue(label.Value)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// 导入资源：按 UUID 导入，其余属性由 Read 填充
+func (r *labelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// 更新资源
+func (r *labelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerLabelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+
+	payload := map[string]interface{}{
+		"name":  plan.Name.ValueString(),
+		"value": plan.Value.ValueString(),
+	}
+	apiPath := fmt.Sprintf("/api/v1/labels/labels/%s/", state.ID.ValueString())
+	if err := r.client.api.Patch(ctx, apiPath, payload, nil); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update labels", "label management", labelFieldPaths)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 删除资源
+func (r *labelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerLabelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/labels/labels/%s/", state.ID.ValueString())
+	if err := r.client.api.Delete(ctx, apiPath, nil, nil); err != nil && !client.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, err, "delete labels", "label management", nil)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+var _ resource.Resource = &loginACLResource{}
+var _ resource.ResourceWithConfigValidators = &loginACLResource{}
+var _ resource.ResourceWithImportState = &loginACLResource{}
+
+// 资源结构体
+type loginACLResource struct {
+	client *jumpServerClient
+}
+
+func LoginACLResource() resource.Resource {
+	return &loginACLResource{}
+}
+
+type JumpServerLoginACLResourceModel struct {
+	ID        types.String        `tfsdk:"id"`        // 只读
+	Name      types.String        `tfsdk:"name"`      // 必填
+	Priority  types.Int64         `tfsdk:"priority"`  // 可选，默认 50
+	Action    types.String        `tfsdk:"action"`    // 必填
+	Users     types.List          `tfsdk:"users"`     // 可选，用户 ID，不设置时作用于所有用户
+	Rules     *LoginACLRulesModel `tfsdk:"rules"`     // 可选，不设置时不限制 IP 和时间
+	Reviewers types.List          `tfsdk:"reviewers"` // 可选，审批人用户 ID，action 为 review 时必填
+}
+
+// API 字段对应的属性，用于按字段报告校验错误
+var loginACLFieldPaths = rootFieldPaths("name", "priority", "action", "users", "rules", "reviewers")
+
+// 登录规则数据模型
+type LoginACLRulesModel struct {
+	IPGroup    types.List `tfsdk:"ip_group"`    // 可选，不设置时为 *
+	TimePeriod types.List `tfsdk:"time_period"` // 可选，不设置时为全天
+}
+
+// 时间段数据模型
+type LoginACLTimePeriodModel struct {
+	ID    types.Int64  `tfsdk:"id"`    // 必填，星期几
+	Value types.String `tfsdk:"value"` // 必填，如 09:00~18:00
+}
+
+// 时间段对象的属性类型，与 schema 中的嵌套对象一致
+var loginACLTimePeriodAttrTypes = map[string]attr.Type{
+	"id":    types.Int64Type,
+	"value": types.StringType,
+}
+
+// 登录 ACL 接口返回的字段
+type apiLoginACL struct {
+	ID        string      `json:"id"`
+	Name      string      `json:"name"`
+	Priority  int64       `json:"priority"`
+	Action    choiceField `json:"action"`
+	Users     aclTarget   `json:"users"`
+	Reviewers []objectRef `json:"reviewers"`
+	Rules     struct {
+		IPGroup    []string `json:"ip_group"`
+		TimePeriod []struct {
+			ID    int64  `json:"id"`
+			Value string `json:"value"`
+		} `json:"time_period"`
+	} `json:"rules"`
+}
+
+func (r *loginACLResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_login_acl"
+}
+
+func (r *loginACLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *loginACLResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the login ACL. Import with `terraform import jumpserver_login_acl.example <uuid>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the login ACL",
+			},
+			"priority": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(50),
+				Description: "The priority of the ACL, from 1 to 100. ACLs with a lower value are matched first",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"action": schema.StringAttribute{
+				Required:    true,
+				Description: "What happens when a login matches: `reject`, `accept`, `review` or `notice`. `review` requires `reviewers`",
+				Validators: []validator.String{
+					stringvalidator.OneOf("reject", "accept", aclActionReview, "notice"),
+				},
+			},
+			"users": schema.ListAttribute{
+				Optional:    true,
+				Description: "The IDs of the users the ACL applies to. When not set, the ACL applies to all users",
+				ElementType: types.StringType,
+				Validators:  uuidListValidators,
+			},
+			"rules": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The login conditions the ACL matches. When not set, logins from any IP at any time match",
+				Attributes: map[string]schema.Attribute{
+					"ip_group": schema.ListAttribute{
+						Optional:    true,
+						Description: "The source IPs, CIDRs or ranges, e.g. `10.0.0.0/8`. When not set, any IP matches",
+						ElementType: types.StringType,
+					},
+					"time_period": schema.ListNestedAttribute{
+						Optional:    true,
+						Description: "The time periods per day of week. When not set, any time matches",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.Int64Attribute{
+									Required:    true,
+									Description: "The day of week, from 0 to 6",
+									Validators: []validator.Int64{
+										int64validator.Between(0, 6),
+									},
+								},
+								"value": schema.StringAttribute{
+									Required:    true,
+									Description: "The periods of the day, e.g. `09:00~18:00`",
+								},
+							},
+						},
+					},
+				},
+			},
+			"reviewers": schema.ListAttribute{
+				Optional:    true,
+				Description: "The IDs of the users who review matched logins. Required when `action` is `review`",
+				ElementType: types.StringType,
+				Validators:  uuidListValidators,
+			},
+		},
+	}
+}
+
+// 配置校验：action 为 review 时必须指定审批人
+func (r *loginACLResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		aclReviewersValidator{},
+	}
+}
+
+// 由计划构造创建/更新请求体
+func buildLoginACLPayload(ctx context.Context, plan JumpServerLoginACLResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// 未设置的规则使用不限制 IP 和时间的默认值
+	ipGroup := []string{"*"}
+	timePeriods := make([]map[string]interface{}, 0, 7)
+	if plan.Rules != nil && !plan.Rules.IPGroup.IsNull() {
+		ipGroup = listStrings(ctx, &diags, plan.Rules.IPGroup)
+	}
+	if plan.Rules != nil && !plan.Rules.TimePeriod.IsNull() {
+		var periods []LoginACLTimePeriodModel
+		diags.Append(plan.Rules.TimePeriod.ElementsAs(ctx, &periods, false)...)
+		for _, period := range periods {
+			timePeriods = append(timePeriods, map[string]interface{}{
+				"id":    period.ID.ValueInt64(),
+				"value": period.Value.ValueString(),
+			})
+		}
+	} else {
+		for day := 0; day < 7; day++ {
+			timePeriods = append(timePeriods, map[string]interface{}{"id": day, "value": "00:00~00:00"})
+		}
+	}
+
+	payload := map[string]interface{}{
+		"name":     plan.Name.ValueString(),
+		"priority": plan.Priority.ValueInt64(),
+		"action":   plan.Action.ValueString(),
+		"users":    expandACLTarget(ctx, &diags, plan.Users),
+		"rules": map[string]interface{}{
+			"ip_group":    ipGroup,
+			"time_period": timePeriods,
+		},
+		"reviewers": listStrings(ctx, &diags, plan.Reviewers),
+	}
+	return payload, diags
+}
+
+// 创建资源
+func (r *loginACLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerLoginACLResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := buildLoginACLPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var acl apiLoginACL
+	if err := r.client.api.Post(ctx, "/api/v1/acls/login-acls/", payload, &acl); err != nil {
+		addAPIError(&resp.Diagnostics, err, "create login ACLs", "ACL management", loginACLFieldPaths)
+		return
+	}
+	if acl.ID == "" {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve login ACL ID from response")
+		return
+	}
+	plan.ID = types.StringValue(acl.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 读取资源
+func (r *loginACLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerLoginACLResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var acl apiLoginACL
+	err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/acls/login-acls/%s/", state.ID.ValueString()), nil, &acl)
+	// ACL 已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read login ACLs", "ACL view", nil)
+		return
+	}
+
+	state.Name = types.StringValue(acl.Name)
+	state.Priority = types.Int64Value(acl.Priority)
+	state.Action = types.StringValue(acl.Action.Value)
+	state.Users = flattenACLTarget(ctx, &resp.Diagnostics, state.Users, acl.Users)
+	state.Reviewers = refreshIDList(ctx, &resp.Diagnostics, state.Reviewers, refIDs(acl.Reviewers))
+
+	// 未配置的规则发送的是默认值，状态为 null 时保持 null
+	if state.Rules != nil {
+		if !state.Rules.IPGroup.IsNull() {
+			state.Rules.IPGroup = refreshIDList(ctx, &resp.Diagnostics, state.Rules.IPGroup, acl.Rules.IPGroup)
+		}
+		if !state.Rules.TimePeriod.IsNull() {
+			periods := make([]LoginACLTimePeriodModel, 0, len(acl.Rules.TimePeriod))
+			for _, period := range acl.Rules.TimePeriod {
+				periods = append(periods, LoginACLTimePeriodModel{
+					ID:    types.Int64Value(period.ID),
+					Value: types.StringValue(period.Value),
+				})
+			}
+			timePeriod, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: loginACLTimePeriodAttrTypes}, periods)
+			resp.Diagnostics.Append(diags...)
+			state.Rules.TimePeriod = timePeriod
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// 导入资源：按 UUID 导入，其余属性由 Read 填充
+func (r *loginACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// 更新资源
+func (r *loginACLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerLoginACLResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+
+	payload, diags := buildLoginACLPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/acls/login-acls/%s/", state.ID.ValueString())
+	if err := r.client.api.Patch(ctx, apiPath, payload, nil); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update login ACLs", "ACL management", loginACLFieldPaths)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 删除资源
+func (r *loginACLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerLoginACLResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/acls/login-acls/%s/", state.ID.ValueString())
+	if err := r.client.api.Delete(ctx, apiPath, nil, nil); err != nil && !client.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, err, "delete login ACLs", "ACL management", nil)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
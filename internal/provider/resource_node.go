@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+var _ resource.Resource = &nodeResource{}
+var _ resource.ResourceWithImportState = &nodeResource{}
+
+// 资源结构体
+type nodeResource struct {
+	client *jumpServerClient
+}
+
+func NodeResource() resource.Resource {
+	return &nodeResource{}
+}
+
+type JumpServerNodeResourceModel struct {
+	ID        types.String `tfsdk:"id"`         // 只读
+	Key       types.String `tfsdk:"key"`        // 只读
+	Value     types.String `tfsdk:"value"`      // 必填
+	FullValue types.String `tfsdk:"full_value"` // 只读
+	ParentID  types.String `tfsdk:"parent_id"`  // 可选，修改后重建
+}
+
+// API 字段对应的属性，用于按字段报告校验错误
+var nodeFieldPaths = rootFieldPaths("value")
+
+// 节点接口返回的字段
+type apiNode struct {
+	ID        string `json:"id"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	FullValue string `json:"full_value"`
+
+	AssetsAmount *int64 `json:"assets_amount"` // 仅列表接口返回
+}
+
+func (r *nodeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node"
+}
+
+func (r *nodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *nodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the node. Import with `terraform import jumpserver_node.example <uuid>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The position of the node in the asset tree, e.g. `1:3:2`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the node",
+			},
+			"full_value": schema.StringAttribute{
+				Computed:    true,
+				Description: "The full path of the node, e.g. `/Default/Linux/Web`, as used in `nodes_display`",
+			},
+			"parent_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the parent node. The node is created under the organization's root node when not set. Changing it recreates the node",
+				Validators:  uuidValidators,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// 创建资源：设置 parent_id 时在父节点下创建子节点
+func (r *nodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerNodeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := "/api/v1/assets/nodes/"
+	if !plan.ParentID.IsNull() {
+		apiPath = fmt.Sprintf("/api/v1/assets/nodes/%s/children/", plan.ParentID.ValueString())
+	}
+
+	payload := map[string]interface{}{"value": plan.Value.ValueString()}
+	var node apiNode
+	if err := r.client.api.Post(ctx, apiPath, payload, &node); err != nil {
+		addAPIError(&resp.Diagnostics, err, "create nodes", "asset management", nodeFieldPaths)
+		return
+	}
+	if node.ID == "" {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve node ID from response")
+		return
+	}
+	plan.setFromAPI(node)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 读取资源
+func (r *nodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerNodeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var node apiNode
+	err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/assets/nodes/%s/", state.ID.ValueString()), nil, &node)
+	// 节点已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read nodes", "asset view", nil)
+		return
+	}
+	state.setFromAPI(node)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// 导入资源：按 UUID 导入，其余属性由 Read 填充
+func (r *nodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// 更新资源：只支持重命名，修改父节点会重建
+func (r *nodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerNodeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/assets/nodes/%s/", state.ID.ValueString())
+	payload := map[string]interface{}{"value": plan.Value.ValueString()}
+	var node apiNode
+	if err := r.client.api.Patch(ctx, apiPath, payload, &node); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update nodes", "asset management", nodeFieldPaths)
+		return
+	}
+	plan.setFromAPI(node)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 删除资源
+func (r *nodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerNodeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/assets/nodes/%s/", state.ID.ValueString())
+	if err := r.client.api.Delete(ctx, apiPath, nil, nil); err != nil && !client.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, err, "delete nodes", "asset management", nil)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// 用 API 返回的节点刷新模型中的计算属性
+func (m *JumpServerNodeResourceModel) setFromAPI(node apiNode) {
+	if node.ID != "" {
+		m.ID = types.StringValue(node.ID)
+	}
+	m.Key = types.StringValue(node.Key)
+	if node.Value != "" {
+		m.Value = types.StringValue(node.Value)
+	}
+	m.FullValue = types.StringValue(node.FullValue)
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+var _ resource.Resource = &organizationResource{}
+var _ resource.ResourceWithImportState = &organizationResource{}
+
+// 资源结构体
+type organizationResource struct {
+	client *jumpServerClient
+}
+
+func OrganizationResource() resource.Resource {
+	return &organizationResource{}
+}
+
+type JumpServerOrganizationResourceModel struct {
+	ID      types.String `tfsdk:"id"`      // 只读
+	Name    types.String `tfsdk:"name"`    // 必填
+	Comment types.String `tfsdk:"comment"` // 可选
+}
+
+// API 字段对应的属性，用于按字段报告校验错误
+var organizationFieldPaths = rootFieldPaths("name", "comment")
+
+// 组织接口返回的字段
+type apiOrganization struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Comment string `json:"comment"`
+}
+
+func (r *organizationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+func (r *organizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *organizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				Description: "The ID of the organization, usable as `org_id` on the provider and resources. " +
+					"Import with `terraform import jumpserver_organization.example <uuid>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the organization",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "A free-form comment on the organization",
+			},
+		},
+	}
+}
+
+// 由计划构造创建/更新请求体
+func buildOrganizationPayload(plan JumpServerOrganizationResourceModel) map[string]interface{} {
+	return map[string]interface{}{
+		"name":    plan.Name.ValueString(),
+		"comment": plan.Comment.ValueString(),
+	}
+}
+
+// 创建资源
+func (r *organizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerOrganizationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var org apiOrganization
+	if err := r.client.api.Post(ctx, "/api/v1/orgs/orgs/", buildOrganizationPayload(plan), &org); err != nil {
+		addAPIError(&resp.Diagnostics, err, "create organizations", "organization management", organizationFieldPaths)
+		return
+	}
+	if org.ID == "" {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve organization ID from response")
+		return
+	}
+	plan.ID = types.StringValue(org.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 读取资源
+func (r *organizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerOrganizationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var org apiOrganization
+	err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/orgs/orgs/%s/", state.ID.ValueString()), nil, &org)
+	// 组织已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read organizations", "organization view", nil)
+		return
+	}
+
+	state.Name = types.StringValue(org.Name)
+	state.Comment = flattenOptionalString(state.Comment, org.Comment)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// 导入资源：按 UUID 导入，其余属性由 Read 填充
+func (r *organizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// 更新资源
+func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerOrganizationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+
+	apiPath := fmt.Sprintf("/api/v1/orgs/orgs/%s/", state.ID.ValueString())
+	if err := r.client.api.Patch(ctx, apiPath, buildOrganizationPayload(plan), nil); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update organizations", "organization management", organizationFieldPaths)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 删除资源
+func (r *organizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerOrganizationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/orgs/orgs/%s/", state.ID.ValueString())
+	err := r.client.api.Delete(ctx, apiPath, nil, nil)
+	// 组织中仍有成员或资源时 JumpServer 拒绝删除，提示用户先清空组织
+	var apiErr *client.Error
+	if errors.As(err, &apiErr) && apiErr.StatusCode() == http.StatusBadRequest {
+		resp.Diagnostics.AddError(
+			"Organization Not Empty",
+			fmt.Sprintf("JumpServer refused to delete organization %q, usually because it still has members, assets or other resources. "+
+				"Remove them from the organization first, then retry. Response: %s", state.Name.ValueString(), string(apiErr.Body)),
+		)
+		return
+	}
+	if err != nil && !client.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, err, "delete organizations", "organization management", nil)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
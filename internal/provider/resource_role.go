@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+var _ resource.Resource = &roleResource{}
+var _ resource.ResourceWithImportState = &roleResource{}
+
+// 资源结构体
+type roleResource struct {
+	client *jumpServerClient
+}
+
+func RoleResource() resource.Resource {
+	return &roleResource{}
+}
+
+type JumpServerRoleResourceModel struct {
+	ID          types.String `tfsdk:"id"`          // 只读
+	Name        types.String `tfsdk:"name"`        // 必填
+	Scope       types.String `tfsdk:"scope"`       // 必填，system 或 org，修改后重建
+	Permissions types.List   `tfsdk:"permissions"` // 可选，权限 ID
+	Comment     types.String `tfsdk:"comment"`     // 可选
+}
+
+// 角色的作用范围
+const (
+	roleScopeSystem = "system"
+	roleScopeOrg    = "org"
+)
+
+// API 字段对应的属性，用于按字段报告校验错误
+var roleFieldPaths = rootFieldPaths("name", "scope", "permissions", "comment")
+
+// 角色接口返回的字段
+type apiRole struct {
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Scope       choiceField `json:"scope"`
+	Permissions []int64     `json:"permissions"`
+	Comment     string      `json:"comment"`
+}
+
+func (r *roleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *roleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the role. Import with `terraform import jumpserver_role.example <uuid>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the role",
+			},
+			"scope": schema.StringAttribute{
+				Required: true,
+				Description: "Where the role applies: `system` for roles bound across JumpServer, or `org` for roles bound " +
+					"within an organization. Changing it replaces the role",
+				Validators: []validator.String{
+					stringvalidator.OneOf(roleScopeSystem, roleScopeOrg),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.ListAttribute{
+				Optional:    true,
+				Description: "The IDs of the permissions the role grants",
+				ElementType: types.Int64Type,
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "A free-form comment on the role",
+			},
+		},
+	}
+}
+
+// 由计划构造创建/更新请求体
+func buildRolePayload(ctx context.Context, plan JumpServerRoleResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	permissions := []int64{}
+	if !plan.Permissions.IsNull() {
+		diags.Append(plan.Permissions.ElementsAs(ctx, &permissions, false)...)
+	}
+
+	payload := map[string]interface{}{
+		"name":        plan.Name.ValueString(),
+		"scope":       plan.Scope.ValueString(),
+		"permissions": permissions,
+		"comment":     plan.Comment.ValueString(),
+	}
+	return payload, diags
+}
+
+// 创建资源
+func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := buildRolePayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var role apiRole
+	if err := r.client.api.Post(ctx, "/api/v1/rbac/roles/", payload, &role); err != nil {
+		addAPIError(&resp.Diagnostics, err, "create roles", "role management", roleFieldPaths)
+		return
+	}
+	if role.ID == "" {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve role ID from response")
+		return
+	}
+	plan.ID = types.StringValue(role.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 读取资源
+func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var role apiRole
+	err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/rbac/roles/%s/", state.ID.ValueString()), nil, &role)
+	// 角色已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read roles", "role view", nil)
+		return
+	}
+
+	state.Name = types.StringValue(role.Name)
+	state.Scope = types.StringValue(role.Scope.Value)
+	state.Permissions = refreshPermissionIDs(ctx, &resp.Diagnostics, state.Permissions, role.Permissions)
+	state.Comment = flattenOptionalString(state.Comment, role.Comment)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// 刷新权限 ID 列表：与状态中的集合相同时保留状态中的顺序，状态为 null 且服务端为空时保持 null
+func refreshPermissionIDs(ctx context.Context, diags *diag.Diagnostics, prior types.List, ids []int64) types.List {
+	if len(ids) == 0 && prior.IsNull() {
+		return prior
+	}
+
+	var priorIDs []int64
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorIDs, false)...)
+	}
+	if len(priorIDs) == len(ids) {
+		remaining := make(map[int64]int, len(ids))
+		for _, id := range ids {
+			remaining[id]++
+		}
+		same := true
+		for _, id := range priorIDs {
+			if remaining[id] == 0 {
+				same = false
+				break
+			}
+			remaining[id]--
+		}
+		if same {
+			return prior
+		}
+	}
+
+	list, d := types.ListValueFrom(ctx, types.Int64Type, ids)
+	diags.Append(d...)
+	return list
+}
+
+// 导入资源：按 UUID 导入，其余属性由 Read 填充
+func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// 更新资源
+func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+
+	payload, diags := buildRolePayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/rbac/roles/%s/", state.ID.ValueString())
+	if err := r.client.api.Patch(ctx, apiPath, payload, nil); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update roles", "role management", roleFieldPaths)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 删除资源
+func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/rbac/roles/%s/", state.ID.ValueString())
+	if err := r.client.api.Delete(ctx, apiPath, nil, nil); err != nil && !client.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, err, "delete roles", "role management", nil)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+var _ resource.Resource = &roleBindingResource{}
+var _ resource.ResourceWithImportState = &roleBindingResource{}
+
+// 资源结构体
+type roleBindingResource struct {
+	client *jumpServerClient
+}
+
+func RoleBindingResource() resource.Resource {
+	return &roleBindingResource{}
+}
+
+// 角色绑定是用户与角色的关联对象，所有属性修改后重建
+type JumpServerRoleBindingResourceModel struct {
+	ID    types.String `tfsdk:"id"`     // 只读
+	User  types.String `tfsdk:"user"`   // 必填，用户 ID
+	Role  types.String `tfsdk:"role"`   // 必填，角色 ID
+	OrgID types.String `tfsdk:"org_id"` // 组织角色必填，系统角色不设置
+}
+
+// API 字段对应的属性，用于按字段报告校验错误
+var roleBindingFieldPaths = rootFieldPaths("user", "role")
+
+// 角色绑定接口返回的字段
+type apiRoleBinding struct {
+	ID   string    `json:"id"`
+	User objectRef `json:"user"`
+	Role objectRef `json:"role"`
+	Org  objectRef `json:"org"`
+}
+
+func (r *roleBindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_binding"
+}
+
+func (r *roleBindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *roleBindingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				Description: "The ID of the role binding. Import with `terraform import jumpserver_role_binding.example <uuid>`, " +
+					"or `<org_id>:<uuid>` for a binding of an organization role",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the user granted the role. Changing it replaces the binding",
+				Validators:  uuidValidators,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the role granted to the user. Changing it replaces the binding",
+				Validators:  uuidValidators,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Optional: true,
+				Description: "The ID of the organization the role is granted in. Required for roles with the `org` scope " +
+					"and not set for `system` roles. Changing it replaces the binding",
+				Validators: uuidValidators,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// 创建资源
+func (r *roleBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerRoleBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := map[string]interface{}{
+		"user": plan.User.ValueString(),
+		"role": plan.Role.ValueString(),
+	}
+	if !plan.OrgID.IsNull() {
+		payload["org"] = plan.OrgID.ValueString()
+	}
+
+	var binding apiRoleBinding
+	if err := r.client.api.Post(ctx, "/api/v1/rbac/role-bindings/", payload, &binding, orgOption(plan.OrgID)); err != nil {
+		addAPIError(&resp.Diagnostics, err, "create role bindings", "role management", roleBindingFieldPaths)
+		return
+	}
+	if binding.ID == "" {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve role binding ID from response")
+		return
+	}
+	plan.ID = types.StringValue(binding.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 读取资源
+func (r *roleBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerRoleBindingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var binding apiRoleBinding
+	apiPath := fmt.Sprintf("/api/v1/rbac/role-bindings/%s/", state.ID.ValueString())
+	err := r.client.api.Get(ctx, apiPath, nil, &binding, orgOption(state.OrgID))
+	// 绑定已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read role bindings", "role view", nil)
+		return
+	}
+
+	state.User = types.StringValue(binding.User.ID)
+	state.Role = types.StringValue(binding.Role.ID)
+	// 系统角色的绑定没有组织
+	state.OrgID = types.StringNull()
+	if binding.Org.ID != "" {
+		state.OrgID = types.StringValue(binding.Org.ID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// 导入资源：按 UUID 导入，或按 "<组织 ID>:<UUID>" 导入组织角色的绑定
+func (r *roleBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateWithOrg(ctx, req, resp)
+}
+
+// 更新资源：所有属性修改后重建，不会调用
+func (r *roleBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan JumpServerRoleBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 删除资源：只删除绑定，用户与角色保留
+func (r *roleBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerRoleBindingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/rbac/role-bindings/%s/", state.ID.ValueString())
+	if err := r.client.api.Delete(ctx, apiPath, nil, nil, orgOption(state.OrgID)); err != nil && !client.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, err, "delete role bindings", "role management", nil)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jumpserver/internal/client"
+)
+
+var _ resource.Resource = &userResource{}
+var _ resource.ResourceWithImportState = &userResource{}
+
+// 资源结构体
+type userResource struct {
+	client *jumpServerClient
+}
+
+func UserResource() resource.Resource {
+	return &userResource{}
+}
+
+type JumpServerUserResourceModel struct {
+	ID       types.String `tfsdk:"id"`        // 只读
+	Name     types.String `tfsdk:"name"`      // 必填
+	Username types.String `tfsdk:"username"`  // 必填
+	Email    types.String `tfsdk:"email"`     // 必填
+	IsActive types.Bool   `tfsdk:"is_active"` // 可选，默认 true
+	MFALevel types.Int64  `tfsdk:"mfa_level"` // 可选，默认 0
+	Groups   types.List   `tfsdk:"groups"`    // 可选，用户组 ID
+	Password types.String `tfsdk:"password"`  // 可选，只写入 API，不从 API 读取
+}
+
+// API 字段对应的属性，用于按字段报告校验错误
+var userFieldPaths = rootFieldPaths("name", "username", "email", "is_active", "mfa_level", "groups", "password")
+
+// 用户接口返回的字段
+type apiUser struct {
+	ID       string      `json:"id"`
+	Name     string      `json:"name"`
+	Username string      `json:"username"`
+	Email    string      `json:"email"`
+	IsActive bool        `json:"is_active"`
+	MFALevel choiceInt   `json:"mfa_level"`
+	Groups   []objectRef `json:"groups"`
+}
+
+// 枚举整数字段：兼容整数与 {"value": ..., "label": ...} 对象两种格式
+type choiceInt struct {
+	Value int64
+}
+
+func (c *choiceInt) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &c.Value); err == nil {
+		return nil
+	}
+	var choice struct {
+		Value int64 `json:"value"`
+	}
+	if err := json.Unmarshal(data, &choice); err != nil {
+		return err
+	}
+	c.Value = choice.Value
+	return nil
+}
+
+func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *userResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jumpServerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpServerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the user. Import with `terraform import jumpserver_user.example <uuid>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The display name of the user",
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "The login name of the user",
+			},
+			"email": schema.StringAttribute{
+				Required:    true,
+				Description: "The email address of the user. JumpServer requires it to create a user",
+			},
+			"is_active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the user can log in",
+			},
+			"mfa_level": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The MFA requirement of the user: `0` disabled, `1` enabled, `2` forced",
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1, 2),
+				},
+			},
+			"groups": schema.ListAttribute{
+				Optional:    true,
+				Description: "The IDs of the user groups the user belongs to",
+				ElementType: types.StringType,
+				Validators:  uuidListValidators,
+			},
+			// The plugin framework in use has no write-only attributes, so the
+			// password is kept in state but marked sensitive and never read back.
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "The initial password of the user. When not set, JumpServer emails the user a link to set one. " +
+					"The password is never read back from JumpServer, so changes made outside Terraform are not detected",
+			},
+		},
+	}
+}
+
+// 创建资源
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan JumpServerUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := buildUserPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// 未设置密码时由 JumpServer 发送邮件让用户自行设置
+	if plan.Password.IsNull() {
+		payload["password_strategy"] = "email"
+	} else {
+		payload["password_strategy"] = "custom"
+		payload["password"] = plan.Password.ValueString()
+	}
+
+	var user apiUser
+	if err := r.client.api.Post(ctx, "/api/v1/users/users/", payload, &user); err != nil {
+		addAPIError(&resp.Diagnostics, err, "create users", "user management", userFieldPaths)
+		return
+	}
+	if user.ID == "" {
+		resp.Diagnostics.AddError("API Error", "Unable to retrieve user ID from response")
+		return
+	}
+	plan.ID = types.StringValue(user.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 由计划构造创建/更新请求体，不包含密码
+func buildUserPayload(ctx context.Context, plan JumpServerUserResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	groups := []string{}
+	if !plan.Groups.IsNull() {
+		diags.Append(plan.Groups.ElementsAs(ctx, &groups, false)...)
+	}
+
+	payload := map[string]interface{}{
+		"name":      plan.Name.ValueString(),
+		"username":  plan.Username.ValueString(),
+		"email":     plan.Email.ValueString(),
+		"is_active": plan.IsActive.ValueBool(),
+		"mfa_level": plan.MFALevel.ValueInt64(),
+		"groups":    groups,
+	}
+	return payload, diags
+}
+
+// 读取资源
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state JumpServerUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user apiUser
+	err := r.client.api.Get(ctx, fmt.Sprintf("/api/v1/users/users/%s/", state.ID.ValueString()), nil, &user)
+	// 用户已在 JumpServer 中删除，从状态中移除
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, err, "read users", "user view", nil)
+		return
+	}
+
+	state.Name = types.StringValue(user.Name)
+	state.Username = types.StringValue(user.Username)
+	state.Email = types.StringValue(user.Email)
+	state.IsActive = types.BoolValue(user.IsActive)
+	state.MFALevel = types.Int64Value(user.MFALevel.Value)
+
+	state.Groups = refreshIDList(ctx, &resp.Diagnostics, state.Groups, refIDs(user.Groups))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// 导入资源：按 UUID 导入，其余属性由 Read 填充
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// 更新资源
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state JumpServerUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+
+	payload, diags := buildUserPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// 只在密码变化时重新设置密码
+	if !plan.Password.IsNull() && !plan.Password.Equal(state.Password) {
+		payload["password"] = plan.Password.ValueString()
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/users/users/%s/", state.ID.ValueString())
+	if err := r.client.api.Patch(ctx, apiPath, payload, nil); err != nil {
+		addAPIError(&resp.Diagnostics, err, "update users", "user management", userFieldPaths)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// 删除资源
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state JumpServerUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/users/users/%s/", state.ID.ValueString())
+	if err := r.client.api.Delete(ctx, apiPath, nil, nil); err != nil && !client.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, err, "delete users", "user management", nil)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.String = hostAddressValidator{}
+	_ validator.String = webAddressValidator{}
+	_ validator.String = rfc3339Validator{}
+	_ validator.String = uuidValidator{}
+)
+
+// hostnameLabel matches a single RFC 1123 DNS label.
+var hostnameLabel = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// hostAddressValidator accepts an IPv4 or IPv6 literal or a DNS hostname.
+type hostAddressValidator struct{}
+
+func (v hostAddressValidator) Description(_ context.Context) string {
+	return "value must be an IPv4 or IPv6 address or a DNS hostname"
+}
+
+func (v hostAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v hostAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if net.ParseIP(value) != nil || isHostname(value) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Address",
+		fmt.Sprintf("%q is not an IP address or hostname. Use a bare address such as 10.0.0.5 or db.example.com, "+
+			"without a scheme, port, path or spaces.", value),
+	)
+}
+
+// isHostname reports whether value is a valid DNS hostname. A single
+// trailing dot is allowed.
+func isHostname(value string) bool {
+	value = strings.TrimSuffix(value, ".")
+	if value == "" || len(value) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(value, ".") {
+		if !hostnameLabel.MatchString(label) {
+			return false
+		}
+	}
+	return true
+}
+
+// webAddressValidator accepts an absolute http or https URL.
+type webAddressValidator struct{}
+
+func (v webAddressValidator) Description(_ context.Context) string {
+	return "value must be an http or https URL"
+}
+
+func (v webAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v webAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	parsed, err := url.Parse(value)
+	if err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != "" {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid URL",
+		fmt.Sprintf("%q is not an http or https URL. Use a full URL such as https://app.example.com/login.", value),
+	)
+}
+
+// rfc3339Validator accepts an RFC 3339 timestamp such as 2024-01-02T15:04:05Z.
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := time.Parse(time.RFC3339, value); err == nil {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Timestamp",
+		fmt.Sprintf("%q is not an RFC 3339 timestamp. Use a value such as 2024-01-02T15:04:05Z or 2024-01-02T15:04:05+08:00, "+
+			"or leave the attribute unset.", value),
+	)
+}
+
+// uuidValidator accepts a UUID, the format JumpServer uses for object IDs.
+type uuidValidator struct{}
+
+func (v uuidValidator) Description(_ context.Context) string {
+	return "value must be a UUID"
+}
+
+func (v uuidValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v uuidValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := uuid.Parse(value); err == nil {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid UUID",
+		fmt.Sprintf("%q is not a valid UUID. JumpServer objects are referenced by ID, "+
+			"e.g. 1f0d3b8e-2c4a-4d5e-9f60-7a8b9c0d1e2f; look IDs up with a data source rather than using names.", value),
+	)
+}
+
+// uuidValidators validate a string attribute holding a JumpServer object ID.
+var uuidValidators = []validator.String{
+	uuidValidator{},
+}
+
+// uuidListValidators validate a list of JumpServer object IDs. Each invalid
+// element is reported on its own index, e.g. assets[2].
+var uuidListValidators = []validator.List{
+	listvalidator.ValueStringsAre(uuidValidator{}),
+}